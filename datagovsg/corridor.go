@@ -0,0 +1,167 @@
+package datagovsg
+
+import (
+	"sort"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// CorridorPosition represents the position of a location relative to the
+// route of a Corridor.
+type CorridorPosition struct {
+	// Distance along the route in metres to the point nearest the location
+	Along float64
+
+	// Distance from the route in metres
+	Offset float64
+}
+
+// CorridorPlace represents a place within a corridor.
+type CorridorPlace struct {
+	Place
+	CorridorPosition
+}
+
+// CorridorCamera represents a traffic camera within a corridor.
+type CorridorCamera struct {
+	TrafficImagesCamera
+	CorridorPosition
+}
+
+// CorridorStation represents a weather station within a corridor.
+type CorridorStation struct {
+	Station
+	CorridorPosition
+}
+
+// CorridorCarpark represents a carpark within a corridor.
+type CorridorCarpark struct {
+	EnrichedCarpark
+	CorridorPosition
+}
+
+// Corridor finds the locations within a buffer distance of a route,
+// ordered by their distance along the route.
+type Corridor struct {
+	route  geo.LineString
+	buffer float64
+	bounds geo.BBox
+}
+
+// NewCorridor returns a new Corridor of the locations within the buffer
+// distance in metres of the route, given as a polyline.
+func NewCorridor(route []geo.Point, buffer float64) *Corridor {
+	line := geo.LineString(route)
+	return &Corridor{
+		route:  line,
+		buffer: buffer,
+		bounds: line.Buffer(buffer),
+	}
+}
+
+// Route returns the route of the corridor.
+func (c *Corridor) Route() []geo.Point {
+	return c.route
+}
+
+// Length returns the length of the route in metres.
+func (c *Corridor) Length() float64 {
+	return c.route.Length()
+}
+
+// Position returns the position of a location relative to the route, and
+// whether it lies within the corridor.
+func (c *Corridor) Position(latitude, longitude float64) (CorridorPosition, bool) {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	if !c.bounds.Contains(p) {
+		return CorridorPosition{}, false
+	}
+	_, along, offset := c.route.Project(p)
+	if offset > c.buffer {
+		return CorridorPosition{}, false
+	}
+	return CorridorPosition{Along: along, Offset: offset}, true
+}
+
+// corridorMatch represents the i-th of a list of locations found within a
+// corridor.
+type corridorMatch struct {
+	index    int
+	position CorridorPosition
+}
+
+// within returns the indices and positions of the n locations within the
+// corridor ordered along the route, where location returns the location of
+// the i-th item and whether it is known.
+func (c *Corridor) within(n int, location func(i int) (geo.Point, bool)) []corridorMatch {
+	var found []corridorMatch
+	for i := 0; i < n; i++ {
+		p, ok := location(i)
+		if !ok {
+			continue
+		}
+		if pos, ok := c.Position(p.Latitude, p.Longitude); ok {
+			found = append(found, corridorMatch{i, pos})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].position.Along < found[j].position.Along
+	})
+	return found
+}
+
+// Places returns the places within the corridor, such as the forecast
+// areas from TwoHourWeatherForecast.Places, ordered along the route.
+func (c *Corridor) Places(places []Place) []CorridorPlace {
+	var found []CorridorPlace
+	for _, m := range c.within(len(places), func(i int) (geo.Point, bool) {
+		return places[i].Point(), true
+	}) {
+		found = append(found, CorridorPlace{places[m.index], m.position})
+	}
+	return found
+}
+
+// Cameras returns the traffic cameras of the latest item within the
+// corridor, ordered along the route.
+func (c *Corridor) Cameras(t *TrafficImages) []CorridorCamera {
+	var found []CorridorCamera
+	if len(t.Items) == 0 {
+		return found
+	}
+	cameras := t.Items[len(t.Items)-1].Cameras
+	for _, m := range c.within(len(cameras), func(i int) (geo.Point, bool) {
+		return geo.Point{Latitude: cameras[i].Location.Latitude, Longitude: cameras[i].Location.Longitude}, true
+	}) {
+		found = append(found, CorridorCamera{cameras[m.index], m.position})
+	}
+	return found
+}
+
+// Stations returns the weather stations within the corridor, such as
+// those from StationCatalog.Stations, ordered along the route.
+func (c *Corridor) Stations(stations []Station) []CorridorStation {
+	var found []CorridorStation
+	for _, m := range c.within(len(stations), func(i int) (geo.Point, bool) {
+		return geo.Point{Latitude: stations[i].Latitude, Longitude: stations[i].Longitude}, true
+	}) {
+		found = append(found, CorridorStation{stations[m.index], m.position})
+	}
+	return found
+}
+
+// Carparks returns the enriched carparks within the corridor, ordered
+// along the route. Carparks without information are omitted.
+func (c *Corridor) Carparks(carparks []EnrichedCarpark) []CorridorCarpark {
+	var found []CorridorCarpark
+	for _, m := range c.within(len(carparks), func(i int) (geo.Point, bool) {
+		info := carparks[i].Information
+		if info == nil {
+			return geo.Point{}, false
+		}
+		return geo.Point{Latitude: info.Latitude, Longitude: info.Longitude}, true
+	}) {
+		found = append(found, CorridorCarpark{carparks[m.index], m.position})
+	}
+	return found
+}
@@ -0,0 +1,77 @@
+package datagovsg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// corridorRoute is a route north along the CTE to Ang Mo Kio.
+var corridorRoute = []geo.Point{
+	{Latitude: 1.3000, Longitude: 103.8580},
+	{Latitude: 1.3400, Longitude: 103.8600},
+	{Latitude: 1.3800, Longitude: 103.8500},
+}
+
+func TestCorridor(t *testing.T) {
+	// Load fixtures
+	ti := &TrafficImages{}
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", ti)
+	at := &AirTemperature{}
+	loadFixture(t, "testdata/fixtures/environment_airtemperature_default.json", at)
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+
+	c := NewCorridor(corridorRoute, 500)
+
+	// Compare cameras
+	var cameras []string
+	for _, camera := range c.Cameras(ti) {
+		if camera.Offset > 500 || camera.Along <= 0 || camera.Along > c.Length() {
+			t.Errorf("camera %v: got position %+v", camera.CameraID, camera.CorridorPosition)
+		}
+		cameras = append(cameras, camera.CameraID)
+	}
+	if want := []string{"1701", "1702"}; !reflect.DeepEqual(cameras, want) {
+		t.Errorf("got cameras %+v want %+v", cameras, want)
+	}
+
+	// Compare stations
+	var stations []string
+	for _, s := range c.Stations(at.Stations()) {
+		stations = append(stations, s.ID)
+	}
+	if want := []string{"S109"}; !reflect.DeepEqual(stations, want) {
+		t.Errorf("got stations %+v want %+v", stations, want)
+	}
+
+	// Compare forecast areas, which must be ordered along the route
+	areas := c.Places(f.Places())
+	if len(areas) == 0 {
+		t.Fatalf("got no forecast areas")
+	}
+	for i, a := range areas {
+		if a.Kind != PlaceKindForecastArea || a.Offset > 500 {
+			t.Errorf("got area %+v", a)
+		}
+		if i > 0 && a.Along < areas[i-1].Along {
+			t.Errorf("got area %v at %v before %v at %v", a.Name, a.Along, areas[i-1].Name, areas[i-1].Along)
+		}
+	}
+
+	// Compare carparks
+	carparks := []EnrichedCarpark{
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "FAR"}, Information: &CarparkInformation{Latitude: 1.3100, Longitude: 103.8365}},
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "NORTH"}, Information: &CarparkInformation{Latitude: 1.3700, Longitude: 103.8540}},
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "UNKNOWN"}},
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "SOUTH"}, Information: &CarparkInformation{Latitude: 1.3050, Longitude: 103.8600}},
+	}
+	var numbers []string
+	for _, cp := range c.Carparks(carparks) {
+		numbers = append(numbers, cp.CarparkNumber)
+	}
+	if want := []string{"SOUTH", "NORTH"}; !reflect.DeepEqual(numbers, want) {
+		t.Errorf("got carparks %+v want %+v", numbers, want)
+	}
+}
@@ -2,17 +2,31 @@
 package datagovsg
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
 	// The base URL for Data.gov.sg API.
 	baseURL = "https://api.data.gov.sg"
+
+	// The base URL for the v2 Data.gov.sg API.
+	openBaseURL = "https://api-open.data.gov.sg"
+
+	// The base URL for the Data.gov.sg datastore API.
+	datastoreBaseURL = "https://data.gov.sg"
+
+	// The default interval between polls of a dataset download.
+	downloadPollInterval = 3 * time.Second
+
+	// The default time limit for a dataset download.
+	downloadTimeout = 5 * time.Minute
 )
 
 var (
@@ -41,22 +55,38 @@ type ErrorResponse struct {
 // Client is a simple http.Client wrapper.
 // TODO: Use http.DefaultClient?
 type Client struct {
-	Client  *http.Client
-	BaseURL string
+	Client           *http.Client
+	BaseURL          string
+	OpenBaseURL      string
+	DatastoreBaseURL string
+
+	// Interval between polls and time limit of a dataset download
+	DownloadPollInterval time.Duration
+	DownloadTimeout      time.Duration
 }
 
 // NewClient returns a new Client object.
 func NewClient() *Client {
 	return &Client{
-		Client:  http.DefaultClient,
-		BaseURL: baseURL,
+		Client:           http.DefaultClient,
+		BaseURL:          baseURL,
+		OpenBaseURL:      openBaseURL,
+		DatastoreBaseURL: datastoreBaseURL,
+
+		DownloadPollInterval: downloadPollInterval,
+		DownloadTimeout:      downloadTimeout,
 	}
 }
 
 // Get executes a HTTP GET request.
 func (c *Client) Get(u *url.URL) ([]byte, error) {
+	return c.GetContext(context.Background(), u)
+}
+
+// GetContext executes a HTTP GET request with the given context.
+func (c *Client) GetContext(ctx context.Context, u *url.URL) ([]byte, error) {
 	// Create request
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package datagovsg
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -61,3 +63,15 @@ func TestClient_Get(t *testing.T) {
 		})
 	}
 }
+
+// loadFixture unmarshals a test fixture into v.
+func loadFixture(t *testing.T, fixture string, v interface{}) {
+	t.Helper()
+	b, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("error loading test fixtures: %v", err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatalf("error unmarshalling fixture: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ResourceIDHDBCarparkInformation is the resource ID of the HDB
+	// carpark information dataset.
+	ResourceIDHDBCarparkInformation = "d_23f946fa557947f93a8043bbef41dd09"
+
+	// ResourceIDHDBResaleFlatPrices is the resource ID of the HDB resale
+	// flat prices dataset (registration date from January 2017 onwards).
+	ResourceIDHDBResaleFlatPrices = "d_8b84c4ee58e3cfc0ece0d773c8ca6abc"
+
+	// ResourceIDSchoolDirectory is the resource ID of the school directory
+	// and information dataset.
+	ResourceIDSchoolDirectory = "d_688b934f82c1059ed0a6993d2a829089"
+)
+
+// DatastoreSearch is the resource representing the results of a
+// datastore search.
+type DatastoreSearch struct {
+	Help    string                `json:"help"`
+	Success bool                  `json:"success"`
+	Result  DatastoreSearchResult `json:"result"`
+}
+
+// DatastoreSearchResult represents the records returned by a datastore
+// search.
+type DatastoreSearchResult struct {
+	// ID of the resource
+	ResourceID string `json:"resource_id"`
+
+	// Fields of the records
+	Fields []DatastoreSearchField `json:"fields"`
+
+	// Records of the resource, left undecoded
+	Records []json.RawMessage `json:"records"`
+
+	// Links to the current and next pages
+	Links DatastoreSearchLinks `json:"_links"`
+
+	// Maximum number of records returned
+	Limit int `json:"limit"`
+
+	// Total number of records matching the search
+	Total int `json:"total"`
+}
+
+// DatastoreSearchField represents a single field of a datastore resource.
+type DatastoreSearchField struct {
+	// Name of the field
+	ID string `json:"id"`
+
+	// Data type of the field
+	Type string `json:"type"`
+}
+
+// DatastoreSearchLinks represents the pagination links of a datastore search.
+type DatastoreSearchLinks struct {
+	// Path of the current page
+	Start string `json:"start"`
+
+	// Path of the next page
+	Next string `json:"next"`
+}
+
+// GetDatastoreSearch returns the records of a datastore resource.
+func (c *Client) GetDatastoreSearch(resourceID string, options ...*QueryOption) (*DatastoreSearch, error) {
+	// Execute request
+	b, err := c.getDatastoreSearch(resourceID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &DatastoreSearch{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// getDatastoreSearch executes a datastore search against a resource and
+// returns the raw response.
+func (c *Client) getDatastoreSearch(resourceID string, options ...*QueryOption) ([]byte, error) {
+	// Parse URL
+	path := "/api/action/datastore_search"
+	u, err := url.Parse(c.DatastoreBaseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set query parameters
+	v := url.Values{}
+	v.Set("resource_id", resourceID)
+	for _, option := range options {
+		v.Add(option.Key, option.Value)
+	}
+	u.RawQuery = v.Encode()
+
+	// Execute request
+	return c.Get(u)
+}
+
+// datastoreValueParser parses the string values of datastore records into
+// their corresponding types, retaining the first error encountered.
+type datastoreValueParser struct {
+	err error
+}
+
+// empty returns true if the value represents a missing value.
+func (p *datastoreValueParser) empty(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "na", "n.a.", "-":
+		return true
+	}
+	return false
+}
+
+// fail records an error for a field if none has been encountered.
+func (p *datastoreValueParser) fail(field, s string, err error) {
+	if p.err == nil {
+		p.err = fmt.Errorf("datagovsg: error parsing %s %q: %w", field, s, err)
+	}
+}
+
+// Float parses a floating point value.
+func (p *datastoreValueParser) Float(field, s string) float64 {
+	if p.empty(s) {
+		return 0
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		p.fail(field, s, err)
+	}
+	return f
+}
+
+// Int parses an integer value.
+func (p *datastoreValueParser) Int(field, s string) int {
+	if p.empty(s) {
+		return 0
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		p.fail(field, s, err)
+	}
+	return i
+}
+
+// Bool parses a Y/N, YES/NO or TRUE/FALSE value.
+func (p *datastoreValueParser) Bool(field, s string) bool {
+	if p.empty(s) {
+		return false
+	}
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "Y", "YES", "TRUE":
+		return true
+	case "N", "NO", "FALSE":
+		return false
+	}
+	p.fail(field, s, strconv.ErrSyntax)
+	return false
+}
+
+// Month parses a YYYY-MM value.
+func (p *datastoreValueParser) Month(field, s string) time.Time {
+	if p.empty(s) {
+		return time.Time{}
+	}
+	t, err := time.Parse("2006-01", strings.TrimSpace(s))
+	if err != nil {
+		p.fail(field, s, err)
+	}
+	return t
+}
+
+// durationPattern matches durations in the form "61 years 04 months".
+var durationPattern = regexp.MustCompile(`^(?:(\d+)\s+years?)?\s*(?:(\d+)\s+months?)?$`)
+
+// Months parses a duration in years and months into the number of months.
+func (p *datastoreValueParser) Months(field, s string) int {
+	if p.empty(s) {
+		return 0
+	}
+	m := durationPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || (m[1] == "" && m[2] == "") {
+		p.fail(field, s, strconv.ErrSyntax)
+		return 0
+	}
+	var months int
+	if m[1] != "" {
+		years, _ := strconv.Atoi(m[1])
+		months += years * 12
+	}
+	if m[2] != "" {
+		n, _ := strconv.Atoi(m[2])
+		months += n
+	}
+	return months
+}
@@ -0,0 +1,108 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_GetDatastoreSearch(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/transport_hdbcarparkinformation_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.DatastoreBaseURL = server.URL
+			got, err := client.GetDatastoreSearch(ResourceIDHDBCarparkInformation)
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &DatastoreSearch{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestDatastoreValueParser(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name  string
+		parse func(p *datastoreValueParser) interface{}
+		want  interface{}
+		err   bool
+	}{
+		{"float", func(p *datastoreValueParser) interface{} { return p.Float("f", "30314.7936") }, 30314.7936, false},
+		{"float_missing", func(p *datastoreValueParser) interface{} { return p.Float("f", "na") }, 0.0, false},
+		{"float_invalid", func(p *datastoreValueParser) interface{} { return p.Float("f", "abc") }, 0.0, true},
+		{"int", func(p *datastoreValueParser) interface{} { return p.Int("i", " 5 ") }, 5, false},
+		{"int_invalid", func(p *datastoreValueParser) interface{} { return p.Int("i", "5.5") }, 0, true},
+		{"bool_yes", func(p *datastoreValueParser) interface{} { return p.Bool("b", "Yes") }, true, false},
+		{"bool_n", func(p *datastoreValueParser) interface{} { return p.Bool("b", "N") }, false, false},
+		{"bool_invalid", func(p *datastoreValueParser) interface{} { return p.Bool("b", "maybe") }, false, true},
+		{"month", func(p *datastoreValueParser) interface{} { return p.Month("m", "2017-01") }, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"month_invalid", func(p *datastoreValueParser) interface{} { return p.Month("m", "Jan 2017") }, time.Time{}, true},
+		{"months", func(p *datastoreValueParser) interface{} { return p.Months("d", "61 years 04 months") }, 736, false},
+		{"months_years_only", func(p *datastoreValueParser) interface{} { return p.Months("d", "60 years") }, 720, false},
+		{"months_invalid", func(p *datastoreValueParser) interface{} { return p.Months("d", "soon") }, 0, true},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &datastoreValueParser{}
+			got := tc.parse(p)
+			if (p.err != nil) != tc.err {
+				t.Errorf("expected error %v but got: %v", tc.err, p.err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package datagovsg
+
+import (
+	"context"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrDownloadFailure is returned by Client.DownloadDataset calls when
+	// the API reports that the dataset download has failed.
+	ErrDownloadFailure = errors.New("datagovsg: dataset download failed")
+
+	// ErrCSVDecode is returned by CSVDecoder.Decode calls when a row could
+	// not be decoded into the given value.
+	ErrCSVDecode = errors.New("datagovsg: error decoding csv row")
+)
+
+// DatasetDownload is the resource representing the state of a dataset
+// download.
+type DatasetDownload struct {
+	Code     int                 `json:"code"`
+	ErrorMsg string              `json:"errorMsg"`
+	Data     DatasetDownloadData `json:"data"`
+}
+
+// DatasetDownloadData represents the state of a dataset download.
+type DatasetDownloadData struct {
+	// Message returned when initiating a download
+	Message string `json:"message"`
+
+	// Status of the download, e.g. "DOWNLOAD_SUCCESS"
+	Status string `json:"status"`
+
+	// Signed URL of the dataset file, available once the download is ready
+	URL string `json:"url"`
+}
+
+// DownloadDataset initiates the download of a dataset, polls until the
+// dataset file is ready and streams the file to w.
+//
+// Polling happens at Client.DownloadPollInterval, or every 3 seconds if it
+// is not positive, and the whole process is aborted after
+// Client.DownloadTimeout or when ctx is done.
+func (c *Client) DownloadDataset(ctx context.Context, datasetID string, w io.Writer) error {
+	if c.DownloadTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.DownloadTimeout)
+		defer cancel()
+	}
+
+	// Initiate download
+	if _, err := c.getDatasetDownload(ctx, datasetID, "initiate-download"); err != nil {
+		return err
+	}
+
+	// Poll download until the file is ready
+	interval := c.DownloadPollInterval
+	if interval <= 0 {
+		interval = downloadPollInterval
+	}
+	var fileURL string
+	for {
+		d, err := c.getDatasetDownload(ctx, datasetID, "poll-download")
+		if err != nil {
+			return err
+		}
+		if d.Data.URL != "" {
+			fileURL = d.Data.URL
+			break
+		}
+		if strings.Contains(strings.ToUpper(d.Data.Status), "FAIL") {
+			return fmt.Errorf("%w: %v", ErrDownloadFailure, d.Data.Status)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	// Stream file
+	req, err := http.NewRequestWithContext(ctx, "GET", fileURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %v", ErrResponseNotOk, resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// DownloadDatasetCSV downloads a dataset in the background and returns a
+// CSVDecoder over its rows. The decoder must be closed once it is no
+// longer needed.
+func (c *Client) DownloadDatasetCSV(ctx context.Context, datasetID string) *CSVDecoder {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(c.DownloadDataset(ctx, datasetID, pw))
+	}()
+	return NewCSVDecoder(pr)
+}
+
+// getDatasetDownload executes a step of the dataset download handshake.
+func (c *Client) getDatasetDownload(ctx context.Context, datasetID, step string) (*DatasetDownload, error) {
+	// Parse URL
+	path := fmt.Sprintf("/v1/public/api/datasets/%s/%s", url.PathEscape(datasetID), step)
+	u, err := url.Parse(c.OpenBaseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute request
+	b, err := c.GetContext(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &DatasetDownload{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	if data.Code != 0 {
+		return nil, fmt.Errorf("%w: %v", ErrDownloadFailure, data.ErrorMsg)
+	}
+	return data, nil
+}
+
+// CSVDecoder iterates over the rows of a CSV file with a header row and
+// decodes them into structs.
+//
+// Struct fields are matched to columns using the `csv` struct tag, or
+// the field name (case-insensitively) if the tag is absent. Fields tagged
+// with `csv:"-"` are ignored.
+type CSVDecoder struct {
+	r      *csv.Reader
+	c      io.Closer
+	header map[string]int
+	row    []string
+	err    error
+}
+
+// NewCSVDecoder returns a new CSVDecoder reading from r.
+func NewCSVDecoder(r io.Reader) *CSVDecoder {
+	d := &CSVDecoder{r: csv.NewReader(r)}
+	if c, ok := r.(io.Closer); ok {
+		d.c = c
+	}
+	return d
+}
+
+// Next advances the decoder to the next row, returning false when there
+// are no more rows or an error has occurred.
+func (d *CSVDecoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+
+	// Read header
+	if d.header == nil {
+		header, err := d.r.Read()
+		if err != nil {
+			d.err = err
+			return false
+		}
+		d.header = make(map[string]int, len(header))
+		for i, name := range header {
+			// Strip byte order mark
+			if i == 0 {
+				name = strings.TrimPrefix(name, "\ufeff")
+			}
+			d.header[strings.ToLower(strings.TrimSpace(name))] = i
+		}
+	}
+
+	// Read row
+	row, err := d.r.Read()
+	if err != nil {
+		d.err = err
+		return false
+	}
+	d.row = row
+	return true
+}
+
+// Err returns the first non-EOF error encountered by the decoder.
+func (d *CSVDecoder) Err() error {
+	if d.err == io.EOF {
+		return nil
+	}
+	return d.err
+}
+
+// Close closes the underlying reader if it implements io.Closer.
+func (d *CSVDecoder) Close() error {
+	if d.c != nil {
+		return d.c.Close()
+	}
+	return nil
+}
+
+// Decode decodes the current row into v, which must be a pointer to a struct.
+func (d *CSVDecoder) Decode(v interface{}) error {
+	if d.row == nil {
+		return fmt.Errorf("%w: no current row", ErrCSVDecode)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected pointer to struct but got %T", ErrCSVDecode, v)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		col, ok := d.header[strings.ToLower(name)]
+		if !ok || col >= len(d.row) {
+			continue
+		}
+		if err := setCSVField(rv.Field(i), d.row[col]); err != nil {
+			return fmt.Errorf("%w: field %s: %v", ErrCSVDecode, field.Name, err)
+		}
+	}
+	return nil
+}
+
+// setCSVField sets the value of a struct field from a CSV value.
+func setCSVField(v reflect.Value, s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const downloadTestCSV = "month,town,floor_area_sqm,lease_commence_date,resale_price\n" +
+	"2017-01,ANG MO KIO,44,1979,232000\n" +
+	"2017-01,BEDOK,67,1978,250000.5\n"
+
+// newDownloadServer returns a mock server which completes the download
+// handshake after the given number of pending polls.
+func newDownloadServer(pending int32, status string) *httptest.Server {
+	var polls int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/v1/public/api/datasets/d_test/initiate-download", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":0,"data":{"message":"Download initiated"},"errorMsg":""}`))
+	})
+	mux.HandleFunc("/v1/public/api/datasets/d_test/poll-download", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) <= pending {
+			fmt.Fprintf(w, `{"code":0,"data":{"status":%q},"errorMsg":""}`, status)
+			return
+		}
+		fmt.Fprintf(w, `{"code":0,"data":{"status":"DOWNLOAD_SUCCESS","url":"%s/files/d_test.csv"},"errorMsg":""}`, server.URL)
+	})
+	mux.HandleFunc("/v1/public/api/datasets/d_missing/initiate-download", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":24005,"data":{},"errorMsg":"Dataset not found"}`))
+	})
+	mux.HandleFunc("/files/d_test.csv", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(downloadTestCSV))
+	})
+	return server
+}
+
+func TestClient_DownloadDataset(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		datasetID string
+		pending   int32
+		status    string
+		timeout   time.Duration
+		want      string
+		err       error
+	}{
+		{"ready", "d_test", 0, "", time.Second, downloadTestCSV, nil},
+		{"pending", "d_test", 2, "DOWNLOAD_IN_PROGRESS", time.Second, downloadTestCSV, nil},
+		{"failed", "d_test", 1, "DOWNLOAD_FAILED", time.Second, "", ErrDownloadFailure},
+		{"not_found", "d_missing", 0, "", time.Second, "", ErrDownloadFailure},
+		{"timeout", "d_test", 1000, "DOWNLOAD_IN_PROGRESS", 50 * time.Millisecond, "", context.DeadlineExceeded},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock HTTP server
+			server := newDownloadServer(tc.pending, tc.status)
+			defer server.Close()
+
+			// Execute request
+			client := NewClient()
+			client.OpenBaseURL = server.URL
+			client.DownloadPollInterval = time.Millisecond
+			client.DownloadTimeout = tc.timeout
+			var buf bytes.Buffer
+			err := client.DownloadDataset(context.Background(), tc.datasetID, &buf)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected error '%v' but got: %v", tc.err, err)
+			}
+
+			// Assert downloaded file
+			if got := buf.String(); got != tc.want {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadDataset_DefaultPollInterval(t *testing.T) {
+	// Mock HTTP server
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/poll-download") {
+			atomic.AddInt32(&polls, 1)
+		}
+		w.Write([]byte(`{"code":0,"data":{"status":"DOWNLOAD_IN_PROGRESS"},"errorMsg":""}`))
+	}))
+	defer server.Close()
+
+	// Execute request
+	client := NewClient()
+	client.OpenBaseURL = server.URL
+	client.DownloadPollInterval = 0
+	client.DownloadTimeout = 100 * time.Millisecond
+	err := client.DownloadDataset(context.Background(), "d_test", ioutil.Discard)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error '%v' but got: %v", context.DeadlineExceeded, err)
+	}
+
+	// Assert that the default interval was used instead of polling
+	// continuously
+	if got := atomic.LoadInt32(&polls); got != 1 {
+		t.Errorf("got %+v want %+v", got, 1)
+	}
+}
+
+type downloadTestRecord struct {
+	Month             string  `csv:"month"`
+	Town              string  `csv:"town"`
+	FloorArea         float64 `csv:"floor_area_sqm"`
+	LeaseCommenceDate int     `csv:"lease_commence_date"`
+	ResalePrice       float64 `csv:"resale_price"`
+	Ignored           string  `csv:"-"`
+}
+
+func TestClient_DownloadDatasetCSV(t *testing.T) {
+	// Mock HTTP server
+	server := newDownloadServer(1, "DOWNLOAD_IN_PROGRESS")
+	defer server.Close()
+
+	// Execute request
+	client := NewClient()
+	client.OpenBaseURL = server.URL
+	client.DownloadPollInterval = time.Millisecond
+	rows := client.DownloadDatasetCSV(context.Background(), "d_test")
+	defer rows.Close()
+
+	// Assert decoded rows
+	var got []downloadTestRecord
+	for rows.Next() {
+		var r downloadTestRecord
+		if err := rows.Decode(&r); err != nil {
+			t.Fatalf("expected no errors but got: %v", err)
+		}
+		got = append(got, r)
+	}
+	if err := rows.Err(); err != nil {
+		t.Errorf("expected no errors but got: %v", err)
+	}
+	want := []downloadTestRecord{
+		{"2017-01", "ANG MO KIO", 44, 1979, 232000, ""},
+		{"2017-01", "BEDOK", 67, 1978, 250000.5, ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestCSVDecoder_Decode(t *testing.T) {
+	type record struct {
+		Name   string
+		Count  int
+		Active bool
+		Since  time.Time `csv:"since"`
+	}
+
+	// Create test cases
+	cases := []struct {
+		name string
+		data string
+		want []record
+		err  bool
+	}{
+		{
+			"field_names",
+			"\ufeffNAME,count,active,since\nfoo,1,true,2020-01-02T03:04:05Z\nbar,,false,\n",
+			[]record{
+				{"foo", 1, true, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)},
+				{"bar", 0, false, time.Time{}},
+			},
+			false,
+		},
+		{"missing_columns", "name\nfoo\n", []record{{Name: "foo"}}, false},
+		{"invalid_value", "name,count\nfoo,many\n", nil, true},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewCSVDecoder(strings.NewReader(tc.data))
+			var got []record
+			var err error
+			for d.Next() {
+				var r record
+				if err = d.Decode(&r); err != nil {
+					break
+				}
+				got = append(got, r)
+			}
+			if (err != nil) != tc.err {
+				t.Errorf("expected error %v but got: %v", tc.err, err)
+			}
+			if err != nil && !errors.Is(err, ErrCSVDecode) {
+				t.Errorf("expected error '%v' but got: %v", ErrCSVDecode, err)
+			}
+			if err := d.Err(); err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
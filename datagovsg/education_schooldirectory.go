@@ -0,0 +1,172 @@
+package datagovsg
+
+import "encoding/json"
+
+// SchoolDirectory is the resource representing the general information
+// of schools.
+type SchoolDirectory struct {
+	Help    string                `json:"help"`
+	Success bool                  `json:"success"`
+	Result  SchoolDirectoryResult `json:"result"`
+}
+
+// SchoolDirectoryResult represents a page of school directory records.
+type SchoolDirectoryResult struct {
+	// ID of the resource
+	ResourceID string `json:"resource_id"`
+
+	// Fields of the records
+	Fields []DatastoreSearchField `json:"fields"`
+
+	// School directory records
+	Records []SchoolDirectoryRecord `json:"records"`
+
+	// Links to the current and next pages
+	Links DatastoreSearchLinks `json:"_links"`
+
+	// Maximum number of records returned
+	Limit int `json:"limit"`
+
+	// Total number of records matching the search
+	Total int `json:"total"`
+}
+
+// SchoolDirectoryRecord represents the general information of a single
+// school.
+type SchoolDirectoryRecord struct {
+	// Name of the school
+	SchoolName string
+
+	// Website of the school
+	URLAddress string
+
+	// Address of the school
+	Address string
+
+	// Postal code of the school
+	PostalCode string
+
+	// Telephone number of the school
+	TelephoneNo string
+
+	// Email address of the school
+	EmailAddress string
+
+	// Nearby MRT stations
+	MRTDesc string
+
+	// Nearby bus services
+	BusDesc string
+
+	// Name of the principal
+	PrincipalName string
+
+	// Development guide plan area of the school
+	DGPCode string
+
+	// Zone of the school, e.g. "NORTH"
+	ZoneCode string
+
+	// Type of the school, e.g. "GOVERNMENT SCHOOL"
+	TypeCode string
+
+	// Nature of the school, e.g. "CO-ED SCHOOL"
+	NatureCode string
+
+	// Session of the school, e.g. "FULL DAY"
+	SessionCode string
+
+	// Main level of the school, e.g. "PRIMARY"
+	MainLevelCode string
+
+	// Whether the school is a Special Assistance Plan school
+	SAP bool
+
+	// Whether the school is an autonomous school
+	Autonomous bool
+
+	// Whether the school offers the Gifted Education Programme
+	Gifted bool
+
+	// Whether the school offers the Integrated Programme
+	IP bool
+
+	// Mother tongue languages offered by the school
+	MotherTongue1 string
+	MotherTongue2 string
+	MotherTongue3 string
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *SchoolDirectoryRecord) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		SchoolName    string `json:"school_name"`
+		URLAddress    string `json:"url_address"`
+		Address       string `json:"address"`
+		PostalCode    string `json:"postal_code"`
+		TelephoneNo   string `json:"telephone_no"`
+		EmailAddress  string `json:"email_address"`
+		MRTDesc       string `json:"mrt_desc"`
+		BusDesc       string `json:"bus_desc"`
+		PrincipalName string `json:"principal_name"`
+		DGPCode       string `json:"dgp_code"`
+		ZoneCode      string `json:"zone_code"`
+		TypeCode      string `json:"type_code"`
+		NatureCode    string `json:"nature_code"`
+		SessionCode   string `json:"session_code"`
+		MainLevelCode string `json:"mainlevel_code"`
+		SAP           string `json:"sap_ind"`
+		Autonomous    string `json:"autonomous_ind"`
+		Gifted        string `json:"gifted_ind"`
+		IP            string `json:"ip_ind"`
+		MotherTongue1 string `json:"mothertongue1_code"`
+		MotherTongue2 string `json:"mothertongue2_code"`
+		MotherTongue3 string `json:"mothertongue3_code"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	p := &datastoreValueParser{}
+	*r = SchoolDirectoryRecord{
+		SchoolName:    raw.SchoolName,
+		URLAddress:    raw.URLAddress,
+		Address:       raw.Address,
+		PostalCode:    raw.PostalCode,
+		TelephoneNo:   raw.TelephoneNo,
+		EmailAddress:  raw.EmailAddress,
+		MRTDesc:       raw.MRTDesc,
+		BusDesc:       raw.BusDesc,
+		PrincipalName: raw.PrincipalName,
+		DGPCode:       raw.DGPCode,
+		ZoneCode:      raw.ZoneCode,
+		TypeCode:      raw.TypeCode,
+		NatureCode:    raw.NatureCode,
+		SessionCode:   raw.SessionCode,
+		MainLevelCode: raw.MainLevelCode,
+		SAP:           p.Bool("sap_ind", raw.SAP),
+		Autonomous:    p.Bool("autonomous_ind", raw.Autonomous),
+		Gifted:        p.Bool("gifted_ind", raw.Gifted),
+		IP:            p.Bool("ip_ind", raw.IP),
+		MotherTongue1: raw.MotherTongue1,
+		MotherTongue2: raw.MotherTongue2,
+		MotherTongue3: raw.MotherTongue3,
+	}
+	return p.err
+}
+
+// GetSchoolDirectory returns the general information of schools.
+func (c *Client) GetSchoolDirectory(options ...*QueryOption) (*SchoolDirectory, error) {
+	// Execute request
+	b, err := c.getDatastoreSearch(ResourceIDSchoolDirectory, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &SchoolDirectory{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
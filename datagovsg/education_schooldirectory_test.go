@@ -0,0 +1,106 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetSchoolDirectory(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/education_schooldirectory_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.DatastoreBaseURL = server.URL
+			got, err := client.GetSchoolDirectory()
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &SchoolDirectory{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestSchoolDirectoryRecord_UnmarshalJSON(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		data string
+		want SchoolDirectoryRecord
+		err  bool
+	}{
+		{
+			"default",
+			`{"school_name":"ANGLO-CHINESE SCHOOL (INDEPENDENT)","postal_code":"139650","sap_ind":"No","ip_ind":"Yes","mothertongue3_code":"na"}`,
+			SchoolDirectoryRecord{SchoolName: "ANGLO-CHINESE SCHOOL (INDEPENDENT)", PostalCode: "139650", IP: true, MotherTongue3: "na"},
+			false,
+		},
+		{
+			"invalid_indicator",
+			`{"school_name":"ADMIRALTY PRIMARY SCHOOL","gifted_ind":"Sometimes"}`,
+			SchoolDirectoryRecord{SchoolName: "ADMIRALTY PRIMARY SCHOOL"},
+			true,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got SchoolDirectoryRecord
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if (err != nil) != tc.err {
+				t.Errorf("expected error %v but got: %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
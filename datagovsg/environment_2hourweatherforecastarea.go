@@ -0,0 +1,114 @@
+package datagovsg
+
+import "github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+
+// ForecastAreaResolver resolves coordinates to the 2-hour weather forecast
+// area they lie in.
+//
+// Areas are only published as label locations, so each area is taken to
+// be the Voronoi cell of its label location clipped to a simplified
+// outline of Singapore and its outlying islands. The main island and each
+// outlying island are only divided among the label locations they contain,
+// so that locations on the shore are not assigned to the area of a nearby
+// island. Islands without any label location are divided among all areas.
+type ForecastAreaResolver struct {
+	forecast *TwoHourWeatherForecast
+	areas    []string
+	cells    []geo.MultiPolygon
+	islands  []forecastIsland
+}
+
+// forecastIsland represents a polygon of the outline of Singapore and the
+// label locations dividing it.
+type forecastIsland struct {
+	boundary geo.Polygon
+	areas    []int
+	index    *geo.Index
+}
+
+// NewForecastAreaResolver returns a new ForecastAreaResolver for the areas
+// and forecasts of the given 2-hour weather forecast.
+func NewForecastAreaResolver(f *TwoHourWeatherForecast) *ForecastAreaResolver {
+	r := &ForecastAreaResolver{
+		forecast: f,
+		areas:    make([]string, len(f.AreaMetadata)),
+		cells:    make([]geo.MultiPolygon, len(f.AreaMetadata)),
+	}
+	sites := make([]geo.Point, len(f.AreaMetadata))
+	for i, a := range f.AreaMetadata {
+		r.areas[i] = a.Name
+		sites[i] = geo.Point{Latitude: a.LabelLocation.Latitude, Longitude: a.LabelLocation.Longitude}
+	}
+	for _, polygon := range singaporeBoundary {
+		var areas []int
+		for i, p := range sites {
+			if polygon.Contains(p) {
+				areas = append(areas, i)
+			}
+		}
+		if len(areas) == 0 {
+			for i := range sites {
+				areas = append(areas, i)
+			}
+		}
+		points := make([]geo.Point, len(areas))
+		for i, a := range areas {
+			points[i] = sites[a]
+		}
+		for i, cell := range geo.VoronoiMultiPolygonCells(points, geo.MultiPolygon{polygon}) {
+			r.cells[areas[i]] = append(r.cells[areas[i]], cell...)
+		}
+		r.islands = append(r.islands, forecastIsland{polygon, areas, geo.NewIndex(points)})
+	}
+	return r
+}
+
+// Area returns the name of the forecast area containing the given
+// coordinates.
+func (r *ForecastAreaResolver) Area(latitude, longitude float64) (string, bool) {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	for _, island := range r.islands {
+		if !island.boundary.Contains(p) {
+			continue
+		}
+		nearest := island.index.Nearest(p, 1)
+		if len(nearest) == 0 {
+			return "", false
+		}
+		return r.areas[island.areas[nearest[0].Index]], true
+	}
+	return "", false
+}
+
+// Resolve returns the latest forecast of the forecast area containing the
+// given coordinates.
+func (r *ForecastAreaResolver) Resolve(latitude, longitude float64) (TwoHourWeatherForecastItemForecast, bool) {
+	area, ok := r.Area(latitude, longitude)
+	if !ok || len(r.forecast.Items) == 0 {
+		return TwoHourWeatherForecastItemForecast{}, false
+	}
+	for _, f := range r.forecast.Items[len(r.forecast.Items)-1].Forecasts {
+		if f.Area == area {
+			return f, true
+		}
+	}
+	return TwoHourWeatherForecastItemForecast{}, false
+}
+
+// Cell returns the boundary of a forecast area, which may be made up of
+// several islands.
+func (r *ForecastAreaResolver) Cell(area string) (geo.MultiPolygon, bool) {
+	for i, a := range r.areas {
+		if a == area {
+			cell := make(geo.MultiPolygon, len(r.cells[i]))
+			for j, polygon := range r.cells[i] {
+				cell[j] = make(geo.Polygon, len(polygon))
+				for k, ring := range polygon {
+					cell[j][k] = append(geo.Ring(nil), ring...)
+				}
+			}
+			return cell, true
+		}
+	}
+	return nil, false
+}
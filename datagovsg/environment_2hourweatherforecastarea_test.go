@@ -0,0 +1,116 @@
+package datagovsg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+func TestForecastAreaResolver_Resolve(t *testing.T) {
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	r := NewForecastAreaResolver(f)
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      string
+		ok        bool
+	}{
+		{"label_location", 1.326, 103.737, "Jurong East", true},
+		{"near_bedok", 1.3240, 103.9300, "Bedok", true},
+		{"raffles_place", 1.2840, 103.8514, "City", true},
+		{"sentosa", 1.2494, 103.8303, "Sentosa", true},
+		{"pulau_tekong", 1.4100, 104.0500, "Pulau Tekong", true},
+		// Locations on the shore resolve to mainland areas, even if the
+		// label location of an island is nearer
+		{"changi_village", 1.3890, 103.9880, "Changi", true},
+		{"punggol_point", 1.4180, 103.9100, "Punggol", true},
+		{"lim_chu_kang", 1.4380, 103.7050, "Lim Chu Kang", true},
+		{"sembawang_park", 1.4600, 103.8365, "Sembawang", true},
+		{"outside_singapore", 1.4927, 103.7414, "", false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := r.Resolve(tc.latitude, tc.longitude)
+			if got.Area != tc.want || ok != tc.ok {
+				t.Errorf("got (%+v, %v) want (%v, %v)", got, ok, tc.want, tc.ok)
+			}
+			if ok && got.Forecast != "Windy" {
+				t.Errorf("got forecast %v want %v", got.Forecast, "Windy")
+			}
+		})
+	}
+}
+
+func TestForecastAreaResolver_LabelLocations(t *testing.T) {
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	r := NewForecastAreaResolver(f)
+
+	// Assert the label location of every area resolves to the area
+	for _, p := range f.Places() {
+		if got, ok := r.Area(p.Latitude, p.Longitude); got != p.Name || !ok {
+			t.Errorf("got (%v, %v) want (%v, %v)", got, ok, p.Name, true)
+		}
+	}
+}
+
+func TestForecastAreaResolver_Cell(t *testing.T) {
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	r := NewForecastAreaResolver(f)
+
+	// Assert every area's label location lies within its own cell
+	for _, a := range f.AreaMetadata {
+		cell, ok := r.Cell(a.Name)
+		if !ok {
+			t.Fatalf("expected cell for area %v", a.Name)
+		}
+		p := Place{Latitude: a.LabelLocation.Latitude, Longitude: a.LabelLocation.Longitude}
+		if !cell.Contains(p.Point()) {
+			t.Errorf("expected label location of %v within its cell", a.Name)
+		}
+	}
+
+	// Assert cells partition the boundary
+	var total float64
+	for _, a := range f.AreaMetadata {
+		cell, _ := r.Cell(a.Name)
+		total += multiPolygonArea(cell)
+	}
+	if want := multiPolygonArea(singaporeBoundary); math.Abs(total-want) > 1e-9 {
+		t.Errorf("got total area %v want %v", total, want)
+	}
+
+	if _, ok := r.Cell("Atlantis"); ok {
+		t.Errorf("expected no cell for unknown area")
+	}
+}
+
+// multiPolygonArea returns the area of the outer rings of a multipolygon in
+// square degrees.
+func multiPolygonArea(m geo.MultiPolygon) float64 {
+	var a float64
+	for _, p := range m {
+		a += ringArea(p[0])
+	}
+	return a
+}
+
+// ringArea returns the area of a ring in square degrees.
+func ringArea(r geo.Ring) float64 {
+	var a float64
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a += r[j].Longitude*r[i].Latitude - r[i].Longitude*r[j].Latitude
+	}
+	return math.Abs(a / 2)
+}
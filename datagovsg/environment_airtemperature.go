@@ -26,47 +26,27 @@ type AirTemperatureMetadata struct {
 
 // AirTemperatureMetadataStation represents metadata information specific to a
 // weather station.
-type AirTemperatureMetadataStation struct {
-	// ID of a station
-	ID string `json:"id"`
-
-	// ID of the device (usually the same as the station)
-	DeviceID string `json:"device_id"`
-
-	// Name of the station
-	Name string `json:"name"`
-
-	// Location of the station
-	Location AirTemperatureMetadataStationLocation `json:"location"`
-}
+type AirTemperatureMetadataStation = StationMetadata
 
 // AirTemperatureMetadataStationLocation represents the geographical coordindates
 // of a weather station.
-type AirTemperatureMetadataStationLocation struct {
-	// Longitude of the station
-	Longitude float64 `json:"longitude"`
-
-	// Latitude of the station
-	Latitude float64 `json:"latitude"`
-}
+type AirTemperatureMetadataStationLocation = StationMetadataLocation
 
 // AirTemperatureItem represents all air temperature readings at a point in time.
-type AirTemperatureItem struct {
-	// Timestamp of the reading
-	Timestamp string `json:"timestamp"`
-
-	// Data readings
-	Readings []AirTemperatureItemReading `json:"readings"`
-}
+type AirTemperatureItem = StationItem
 
 // AirTemperatureItemReading represents a single air temperature reading at
 // a specific station at a point in time.
-type AirTemperatureItemReading struct {
-	// ID of the station
-	StationID string `json:"station_id"`
+type AirTemperatureItemReading = StationItemReading
+
+// Stations returns the weather stations providing air temperature readings.
+func (a *AirTemperature) Stations() []Station {
+	return stationsOf(a.Metadata.Stations, MeasurementAirTemperature)
+}
 
-	// Value of the reading
-	Value float64 `json:"value"`
+// StationReadings returns the air temperature readings of every item.
+func (a *AirTemperature) StationReadings() []StationReading {
+	return stationReadingsOf(a.Items, MeasurementAirTemperature, a.Metadata.ReadingUnit)
 }
 
 // GetAirTemperature returns the air temperature information.
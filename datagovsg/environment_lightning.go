@@ -0,0 +1,155 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// LightningType represents the type of a lightning strike.
+type LightningType string
+
+const (
+	// LightningTypeCloudToGround represents a cloud-to-ground strike.
+	LightningTypeCloudToGround LightningType = "G"
+
+	// LightningTypeIntraCloud represents an intra-cloud strike.
+	LightningTypeIntraCloud LightningType = "C"
+)
+
+// Lightning is the resource representing the lightning observations.
+type Lightning struct {
+	Code     int           `json:"code"`
+	ErrorMsg string        `json:"errorMsg"`
+	Data     LightningData `json:"data"`
+}
+
+// LightningData represents the lightning observation records.
+type LightningData struct {
+	// Observation records
+	Records []LightningRecord `json:"records"`
+
+	// Token for retrieving the next page of records
+	PaginationToken string `json:"paginationToken"`
+}
+
+// LightningRecord represents all lightning observations at a point in time.
+type LightningRecord struct {
+	// Timestamp of the observation
+	Datetime string `json:"datetime"`
+
+	// Observation details
+	Item LightningRecordItem `json:"item"`
+
+	// Timestamp of data acquisition
+	UpdatedTimestamp string `json:"updatedTimestamp"`
+}
+
+// LightningRecordItem represents a set of lightning observations.
+type LightningRecordItem struct {
+	// Whether the observation is made by a weather station
+	IsStationData bool `json:"isStationData"`
+
+	// Type of the item
+	Type string `json:"type"`
+
+	// Data readings
+	Readings []LightningReading `json:"readings"`
+}
+
+// LightningReading represents a single lightning strike.
+type LightningReading struct {
+	// Location of the strike
+	Location LightningReadingLocation `json:"location"`
+
+	// Timestamp of the strike
+	Datetime string `json:"datetime"`
+
+	// Description of the location
+	Text string `json:"text"`
+
+	// Type of the strike
+	Type LightningType `json:"type"`
+}
+
+// LightningReadingLocation represents the geographical coordinates
+// of a lightning strike.
+type LightningReadingLocation struct {
+	// Longitude of the strike
+	Longitude float64 `json:"longitude,string"`
+
+	// Latitude of the strike
+	Latitude float64 `json:"latitude,string"`
+}
+
+// Readings returns the lightning readings across all records.
+func (l *Lightning) Readings() []LightningReading {
+	var readings []LightningReading
+	for _, record := range l.Data.Records {
+		readings = append(readings, record.Item.Readings...)
+	}
+	return readings
+}
+
+// Within returns the lightning readings that are located within radius
+// metres of the given coordinates.
+func (l *Lightning) Within(latitude, longitude, radius float64) []LightningReading {
+	var readings []LightningReading
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	for _, reading := range l.Readings() {
+		q := geo.Point{Latitude: reading.Location.Latitude, Longitude: reading.Location.Longitude}
+		if geo.Distance(p, q) <= radius {
+			readings = append(readings, reading)
+		}
+	}
+	return readings
+}
+
+// Recent returns the lightning readings that occurred within the given
+// duration before now.
+func (l *Lightning) Recent(now time.Time, d time.Duration) ([]LightningReading, error) {
+	var readings []LightningReading
+	for _, reading := range l.Readings() {
+		t, err := time.Parse(time.RFC3339, reading.Datetime)
+		if err != nil {
+			return nil, err
+		}
+		if !t.After(now) && now.Sub(t) <= d {
+			readings = append(readings, reading)
+		}
+	}
+	return readings, nil
+}
+
+// GetLightning returns the lightning observations.
+func (c *Client) GetLightning(options ...*QueryOption) (*Lightning, error) {
+	// Parse URL
+	path := "/v2/real-time/api/weather"
+	u, err := url.Parse(c.OpenBaseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set query parameters
+	v := url.Values{}
+	v.Set("api", "lightning")
+	for _, option := range options {
+		v.Add(option.Key, option.Value)
+	}
+	u.RawQuery = v.Encode()
+
+	// Execute request
+	b, err := c.Get(u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &Lightning{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
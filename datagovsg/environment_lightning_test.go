@@ -0,0 +1,146 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_GetLightning(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/environment_lightning_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.OpenBaseURL = server.URL
+			got, err := client.GetLightning()
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &Lightning{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLightning_Within(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		radius    float64
+		want      []string
+	}{
+		{"clementi_1km", 1.3337, 103.7765, 1000, []string{"Clementi", "Bukit Timah"}},
+		{"clementi_100m", 1.3337, 103.7765, 100, []string{"Clementi"}},
+		{"changi_5km", 1.3644, 103.9915, 5000, []string{"Changi"}},
+		{"sentosa_1km", 1.2494, 103.8303, 1000, nil},
+	}
+
+	// Load fixtures
+	l := &Lightning{}
+	loadFixture(t, "testdata/fixtures/environment_lightning_default.json", l)
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, reading := range l.Within(tc.latitude, tc.longitude, tc.radius) {
+				got = append(got, reading.Text)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLightning_Recent(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		now  string
+		d    time.Duration
+		want []string
+	}{
+		{"last_5m", "2024-07-16T16:15:00+08:00", 5 * time.Minute, []string{"Clementi", "Bukit Timah"}},
+		{"last_15m", "2024-07-16T16:15:00+08:00", 15 * time.Minute, []string{"Clementi", "Bukit Timah", "Changi"}},
+		{"before_strikes", "2024-07-16T16:00:00+08:00", 15 * time.Minute, nil},
+		{"utc", "2024-07-16T08:12:00Z", time.Minute, []string{"Clementi"}},
+	}
+
+	// Load fixtures
+	l := &Lightning{}
+	loadFixture(t, "testdata/fixtures/environment_lightning_default.json", l)
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now, err := time.Parse(time.RFC3339, tc.now)
+			if err != nil {
+				t.Fatalf("error parsing time: %v", err)
+			}
+			readings, err := l.Recent(now, tc.d)
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+			var got []string
+			for _, reading := range readings {
+				got = append(got, reading.Text)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
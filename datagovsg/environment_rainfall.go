@@ -26,47 +26,27 @@ type RainfallMetadata struct {
 
 // RainfallMetadataStation represents metadata information specific to a
 // weather station.
-type RainfallMetadataStation struct {
-	// ID of a station
-	ID string `json:"id"`
-
-	// ID of the device (usually the same as the station)
-	DeviceID string `json:"device_id"`
-
-	// Name of the station
-	Name string `json:"name"`
-
-	// Location of the station
-	Location RainfallMetadataStationLocation `json:"location"`
-}
+type RainfallMetadataStation = StationMetadata
 
 // RainfallMetadataStationLocation represents the geographical coordindates
 // of a weather station.
-type RainfallMetadataStationLocation struct {
-	// Longitude of the station
-	Longitude float64 `json:"longitude"`
-
-	// Latitude of the station
-	Latitude float64 `json:"latitude"`
-}
+type RainfallMetadataStationLocation = StationMetadataLocation
 
 // RainfallItem represents all rainfall readings at a point in time.
-type RainfallItem struct {
-	// Timestamp of the reading
-	Timestamp string `json:"timestamp"`
-
-	// Data readings
-	Readings []RainfallItemReading `json:"readings"`
-}
+type RainfallItem = StationItem
 
 // RainfallItemReading represents a single rainfall reading at
 // a specific station at a point in time.
-type RainfallItemReading struct {
-	// ID of the station
-	StationID string `json:"station_id"`
+type RainfallItemReading = StationItemReading
+
+// Stations returns the weather stations providing rainfall readings.
+func (r *Rainfall) Stations() []Station {
+	return stationsOf(r.Metadata.Stations, MeasurementRainfall)
+}
 
-	// Value of the reading
-	Value float64 `json:"value"`
+// StationReadings returns the rainfall readings of every item.
+func (r *Rainfall) StationReadings() []StationReading {
+	return stationReadingsOf(r.Items, MeasurementRainfall, r.Metadata.ReadingUnit)
 }
 
 // GetRainfall returns the rainfall information.
@@ -26,47 +26,27 @@ type RelativeHumidityMetadata struct {
 
 // RelativeHumidityMetadataStation represents metadata information specific to a
 // weather station.
-type RelativeHumidityMetadataStation struct {
-	// ID of a station
-	ID string `json:"id"`
-
-	// ID of the device (usually the same as the station)
-	DeviceID string `json:"device_id"`
-
-	// Name of the station
-	Name string `json:"name"`
-
-	// Location of the station
-	Location RelativeHumidityMetadataStationLocation `json:"location"`
-}
+type RelativeHumidityMetadataStation = StationMetadata
 
 // RelativeHumidityMetadataStationLocation represents the geographical coordindates
 // of a weather station.
-type RelativeHumidityMetadataStationLocation struct {
-	// Longitude of the station
-	Longitude float64 `json:"longitude"`
-
-	// Latitude of the station
-	Latitude float64 `json:"latitude"`
-}
+type RelativeHumidityMetadataStationLocation = StationMetadataLocation
 
 // RelativeHumidityItem represents all relative humidity readings at a point in time.
-type RelativeHumidityItem struct {
-	// Timestamp of the reading
-	Timestamp string `json:"timestamp"`
-
-	// Data readings
-	Readings []RelativeHumidityItemReading `json:"readings"`
-}
+type RelativeHumidityItem = StationItem
 
 // RelativeHumidityItemReading represents a single relative humidity reading at
 // a specific station at a point in time.
-type RelativeHumidityItemReading struct {
-	// ID of the station
-	StationID string `json:"station_id"`
+type RelativeHumidityItemReading = StationItemReading
+
+// Stations returns the weather stations providing relative humidity readings.
+func (r *RelativeHumidity) Stations() []Station {
+	return stationsOf(r.Metadata.Stations, MeasurementRelativeHumidity)
+}
 
-	// Value of the reading
-	Value float64 `json:"value"`
+// StationReadings returns the relative humidity readings of every item.
+func (r *RelativeHumidity) StationReadings() []StationReading {
+	return stationReadingsOf(r.Items, MeasurementRelativeHumidity, r.Metadata.ReadingUnit)
 }
 
 // GetRelativeHumidity returns the relative humidity information.
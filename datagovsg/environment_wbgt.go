@@ -0,0 +1,202 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// WBGTHeatStress represents the heat stress category of a WBGT reading.
+type WBGTHeatStress string
+
+const (
+	// WBGTHeatStressLow represents a WBGT below 31°C.
+	WBGTHeatStressLow WBGTHeatStress = "Low"
+
+	// WBGTHeatStressModerate represents a WBGT from 31°C to below 33°C.
+	WBGTHeatStressModerate WBGTHeatStress = "Moderate"
+
+	// WBGTHeatStressHigh represents a WBGT of 33°C and above.
+	WBGTHeatStressHigh WBGTHeatStress = "High"
+)
+
+// WBGT is the resource representing the Wet Bulb Globe Temperature information.
+type WBGT struct {
+	Code     int      `json:"code"`
+	ErrorMsg string   `json:"errorMsg"`
+	Data     WBGTData `json:"data"`
+}
+
+// WBGTData represents the WBGT records.
+type WBGTData struct {
+	// Observation records
+	Records []WBGTRecord `json:"records"`
+
+	// Token for retrieving the next page of records
+	PaginationToken string `json:"paginationToken"`
+}
+
+// WBGTRecord represents all WBGT readings at a point in time.
+type WBGTRecord struct {
+	// Timestamp of the reading
+	Datetime string `json:"datetime"`
+
+	// Reading details
+	Item WBGTRecordItem `json:"item"`
+
+	// Timestamp of data acquisition
+	UpdatedTimestamp string `json:"updatedTimestamp"`
+}
+
+// WBGTRecordItem represents a set of WBGT readings.
+type WBGTRecordItem struct {
+	// Whether the readings are made by weather stations
+	IsStationData bool `json:"isStationData"`
+
+	// Type of the item
+	Type string `json:"type"`
+
+	// Data readings
+	Readings []WBGTReading `json:"readings"`
+}
+
+// WBGTReading represents a single WBGT reading at a specific station
+// at a point in time.
+type WBGTReading struct {
+	// Location of the station
+	Location WBGTReadingLocation `json:"location"`
+
+	// Station which made the reading
+	Station WBGTReadingStation `json:"station"`
+
+	// Value of the reading
+	WBGT float64 `json:"wbgt,string"`
+
+	// Heat stress category of the reading
+	HeatStress WBGTHeatStress `json:"heatStress"`
+}
+
+// WBGTReadingLocation represents the geographical coordinates of a
+// weather station.
+type WBGTReadingLocation struct {
+	// Longitude of the station
+	Longitude float64 `json:"longitude,string"`
+
+	// Latitude of the station
+	Latitude float64 `json:"latitude,string"`
+}
+
+// WBGTReadingStation represents the weather station of a reading.
+type WBGTReadingStation struct {
+	// ID of the station
+	ID string `json:"id"`
+
+	// Name of the station
+	Name string `json:"name"`
+}
+
+// WBGTMetadata represents metadata information about the WBGT data.
+type WBGTMetadata struct {
+	// Metadata about a weather station
+	Stations []WBGTMetadataStation `json:"stations"`
+}
+
+// WBGTMetadataStation represents metadata information specific to a
+// weather station.
+type WBGTMetadataStation = StationMetadata
+
+// WBGTMetadataStationLocation represents the geographical coordindates
+// of a weather station.
+type WBGTMetadataStationLocation = StationMetadataLocation
+
+// WBGTItem represents the readings of every station in a record, in the
+// same shape as the items of the other weather resources.
+type WBGTItem = StationItem
+
+// WBGTItemReading represents a single reading at a specific station in a
+// record.
+type WBGTItemReading = StationItemReading
+
+// Metadata returns the metadata of every station found in the readings.
+//
+// Unlike the v1 weather endpoints, the WBGT endpoint embeds the station
+// information within each reading, so the metadata is collated here in
+// the same shape as the other weather resources.
+func (w *WBGT) Metadata() WBGTMetadata {
+	var metadata WBGTMetadata
+	seen := make(map[string]bool)
+	for _, record := range w.Data.Records {
+		for _, reading := range record.Item.Readings {
+			if seen[reading.Station.ID] {
+				continue
+			}
+			seen[reading.Station.ID] = true
+			metadata.Stations = append(metadata.Stations, WBGTMetadataStation{
+				ID:       reading.Station.ID,
+				DeviceID: reading.Station.ID,
+				Name:     reading.Station.Name,
+				Location: WBGTMetadataStationLocation{
+					Longitude: reading.Location.Longitude,
+					Latitude:  reading.Location.Latitude,
+				},
+			})
+		}
+	}
+	return metadata
+}
+
+// Stations returns the weather stations providing WBGT readings.
+func (w *WBGT) Stations() []Station {
+	return stationsOf(w.Metadata().Stations, MeasurementWBGT)
+}
+
+// Items returns the readings of every record in the same shape as the
+// items of the other weather resources.
+func (w *WBGT) Items() []WBGTItem {
+	items := make([]WBGTItem, len(w.Data.Records))
+	for i, record := range w.Data.Records {
+		items[i].Timestamp = record.Datetime
+		for _, r := range record.Item.Readings {
+			items[i].Readings = append(items[i].Readings, WBGTItemReading{
+				StationID: r.Station.ID,
+				Value:     r.WBGT,
+			})
+		}
+	}
+	return items
+}
+
+// StationReadings returns the WBGT readings of every record.
+func (w *WBGT) StationReadings() []StationReading {
+	return stationReadingsOf(w.Items(), MeasurementWBGT, "")
+}
+
+// GetWBGT returns the Wet Bulb Globe Temperature information.
+func (c *Client) GetWBGT(options ...*QueryOption) (*WBGT, error) {
+	// Parse URL
+	path := "/v2/real-time/api/weather"
+	u, err := url.Parse(c.OpenBaseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set query parameters
+	v := url.Values{}
+	v.Set("api", "wbgt")
+	for _, option := range options {
+		v.Add(option.Key, option.Value)
+	}
+	u.RawQuery = v.Encode()
+
+	// Execute request
+	b, err := c.Get(u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &WBGT{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
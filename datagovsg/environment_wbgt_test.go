@@ -0,0 +1,94 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetWBGT(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/environment_wbgt_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.OpenBaseURL = server.URL
+			got, err := client.GetWBGT()
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &WBGT{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestWBGT_Metadata(t *testing.T) {
+	// Load fixtures
+	w := &WBGT{}
+	loadFixture(t, "testdata/fixtures/environment_wbgt_default.json", w)
+
+	// Assert stations are deduplicated across records
+	got := w.Metadata()
+	want := WBGTMetadata{
+		Stations: []WBGTMetadataStation{
+			{"S128", "S128", "Bishan Street", WBGTMetadataStationLocation{103.8501, 1.3521}},
+			{"S50", "S50", "Clementi Road", WBGTMetadataStationLocation{103.7768, 1.3337}},
+			{"S24", "S24", "Upper Changi Road North", WBGTMetadataStationLocation{103.9826, 1.3678}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	// Assert heat stress categories are decoded
+	readings := w.Data.Records[0].Item.Readings
+	categories := []WBGTHeatStress{WBGTHeatStressLow, WBGTHeatStressModerate, WBGTHeatStressHigh}
+	for i, category := range categories {
+		if readings[i].HeatStress != category {
+			t.Errorf("got %v want %v", readings[i].HeatStress, category)
+		}
+	}
+}
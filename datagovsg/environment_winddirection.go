@@ -26,47 +26,27 @@ type WindDirectionMetadata struct {
 
 // WindDirectionMetadataStation represents metadata information specific to a
 // weather station.
-type WindDirectionMetadataStation struct {
-	// ID of a station
-	ID string `json:"id"`
-
-	// ID of the device (usually the same as the station)
-	DeviceID string `json:"device_id"`
-
-	// Name of the station
-	Name string `json:"name"`
-
-	// Location of the station
-	Location WindDirectionMetadataStationLocation `json:"location"`
-}
+type WindDirectionMetadataStation = StationMetadata
 
 // WindDirectionMetadataStationLocation represents the geographical coordindates
 // of a weather station.
-type WindDirectionMetadataStationLocation struct {
-	// Longitude of the station
-	Longitude float64 `json:"longitude"`
-
-	// Latitude of the station
-	Latitude float64 `json:"latitude"`
-}
+type WindDirectionMetadataStationLocation = StationMetadataLocation
 
 // WindDirectionItem represents all wind direction readings at a point in time.
-type WindDirectionItem struct {
-	// Timestamp of the reading
-	Timestamp string `json:"timestamp"`
-
-	// Data readings
-	Readings []WindDirectionItemReading `json:"readings"`
-}
+type WindDirectionItem = StationItem
 
 // WindDirectionItemReading represents a single wind direction reading at
 // a specific station at a point in time.
-type WindDirectionItemReading struct {
-	// ID of the station
-	StationID string `json:"station_id"`
+type WindDirectionItemReading = StationItemReading
+
+// Stations returns the weather stations providing wind direction readings.
+func (w *WindDirection) Stations() []Station {
+	return stationsOf(w.Metadata.Stations, MeasurementWindDirection)
+}
 
-	// Value of the reading
-	Value float64 `json:"value"`
+// StationReadings returns the wind direction readings of every item.
+func (w *WindDirection) StationReadings() []StationReading {
+	return stationReadingsOf(w.Items, MeasurementWindDirection, w.Metadata.ReadingUnit)
 }
 
 // GetWindDirection returns the wind direction information.
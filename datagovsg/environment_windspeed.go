@@ -26,47 +26,27 @@ type WindSpeedMetadata struct {
 
 // WindSpeedMetadataStation represents metadata information specific to a
 // weather station.
-type WindSpeedMetadataStation struct {
-	// ID of a station
-	ID string `json:"id"`
-
-	// ID of the device (usually the same as the station)
-	DeviceID string `json:"device_id"`
-
-	// Name of the station
-	Name string `json:"name"`
-
-	// Location of the station
-	Location WindSpeedMetadataStationLocation `json:"location"`
-}
+type WindSpeedMetadataStation = StationMetadata
 
 // WindSpeedMetadataStationLocation represents the geographical coordindates
 // of a weather station.
-type WindSpeedMetadataStationLocation struct {
-	// Longitude of the station
-	Longitude float64 `json:"longitude"`
-
-	// Latitude of the station
-	Latitude float64 `json:"latitude"`
-}
+type WindSpeedMetadataStationLocation = StationMetadataLocation
 
 // WindSpeedItem represents all wind speed readings at a point in time.
-type WindSpeedItem struct {
-	// Timestamp of the reading
-	Timestamp string `json:"timestamp"`
-
-	// Data readings
-	Readings []WindSpeedItemReading `json:"readings"`
-}
+type WindSpeedItem = StationItem
 
 // WindSpeedItemReading represents a single wind speed reading at
 // a specific station at a point in time.
-type WindSpeedItemReading struct {
-	// ID of the station
-	StationID string `json:"station_id"`
+type WindSpeedItemReading = StationItemReading
+
+// Stations returns the weather stations providing wind speed readings.
+func (w *WindSpeed) Stations() []Station {
+	return stationsOf(w.Metadata.Stations, MeasurementWindSpeed)
+}
 
-	// Value of the reading
-	Value float64 `json:"value"`
+// StationReadings returns the wind speed readings of every item.
+func (w *WindSpeed) StationReadings() []StationReading {
+	return stationReadingsOf(w.Items, MeasurementWindSpeed, w.Metadata.ReadingUnit)
 }
 
 // GetWindSpeed returns the wind speed information.
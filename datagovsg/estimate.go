@@ -0,0 +1,225 @@
+package datagovsg
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+const (
+	// The default power parameter of inverse-distance weighting.
+	interpolatorPower = 2
+
+	// Distance in metres within which a station is considered to be
+	// located at the point being estimated.
+	interpolatorCoincident = 1
+)
+
+var (
+	// ErrNoObservations is returned by EstimateWeather calls when no
+	// observations are given.
+	ErrNoObservations = errors.New("datagovsg: no weather observations")
+)
+
+// WeatherObservations represents the latest observations used to estimate
+// the weather at a point. Any of the resources may be nil, in which case
+// the corresponding estimate is omitted.
+type WeatherObservations struct {
+	AirTemperature   *AirTemperature
+	RelativeHumidity *RelativeHumidity
+	Rainfall         *Rainfall
+	WindSpeed        *WindSpeed
+	WindDirection    *WindDirection
+}
+
+// WeatherEstimate represents the estimated weather at a point. Estimates
+// are nil if no station could contribute to them.
+type WeatherEstimate struct {
+	AirTemperature   *Estimate
+	RelativeHumidity *Estimate
+	Rainfall         *Estimate
+	WindSpeed        *Estimate
+	WindDirection    *Estimate
+}
+
+// Estimate represents a value interpolated from station readings.
+type Estimate struct {
+	// Interpolated value
+	Value float64
+
+	// Stations which contributed to the value, ordered by increasing distance
+	Contributions []Contribution
+}
+
+// Contribution represents the contribution of a station to an Estimate.
+type Contribution struct {
+	// ID of the station
+	StationID string
+
+	// Distance of the station in metres
+	Distance float64
+
+	// Normalised weight of the reading, which sums to 1 across contributions
+	Weight float64
+
+	// Value of the reading
+	Value float64
+}
+
+// Interpolator estimates values at a point from station readings using
+// inverse-distance weighting.
+type Interpolator struct {
+	// Power parameter controlling how quickly the influence of a station
+	// decreases with distance
+	Power float64
+
+	// Maximum distance in metres of contributing stations, or 0 for no limit
+	MaxDistance float64
+
+	// Maximum number of nearest contributing stations, or 0 for no limit
+	MaxStations int
+}
+
+// NewInterpolator returns a new Interpolator object.
+func NewInterpolator() *Interpolator {
+	return &Interpolator{
+		Power: interpolatorPower,
+	}
+}
+
+// EstimateWeather estimates the weather at the given coordinates using the
+// default Interpolator.
+func EstimateWeather(latitude, longitude float64, obs *WeatherObservations) (*WeatherEstimate, error) {
+	return NewInterpolator().EstimateWeather(latitude, longitude, obs)
+}
+
+// EstimateWeather estimates the weather at the given coordinates from the
+// latest item of each observation. Stations without a reading in the
+// latest item are skipped. Wind direction is estimated by vector averaging.
+func (ip *Interpolator) EstimateWeather(latitude, longitude float64, obs *WeatherObservations) (*WeatherEstimate, error) {
+	if obs == nil {
+		return nil, ErrNoObservations
+	}
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	e := &WeatherEstimate{}
+	if obs.AirTemperature != nil {
+		e.AirTemperature = ip.scalar(p, latestSamples(obs.AirTemperature))
+	}
+	if obs.RelativeHumidity != nil {
+		e.RelativeHumidity = ip.scalar(p, latestSamples(obs.RelativeHumidity))
+	}
+	if obs.Rainfall != nil {
+		e.Rainfall = ip.scalar(p, latestSamples(obs.Rainfall))
+	}
+	if obs.WindSpeed != nil {
+		e.WindSpeed = ip.scalar(p, latestSamples(obs.WindSpeed))
+	}
+	if obs.WindDirection != nil {
+		e.WindDirection = ip.direction(p, latestSamples(obs.WindDirection))
+	}
+	return e, nil
+}
+
+// scalar returns the weighted mean of the samples.
+func (ip *Interpolator) scalar(p geo.Point, samples []sample) *Estimate {
+	contributions := ip.weigh(p, samples)
+	if contributions == nil {
+		return nil
+	}
+	e := &Estimate{Contributions: contributions}
+	for _, c := range contributions {
+		e.Value += c.Weight * c.Value
+	}
+	return e
+}
+
+// direction returns the weighted vector mean of the samples, which are
+// bearings in degrees.
+func (ip *Interpolator) direction(p geo.Point, samples []sample) *Estimate {
+	contributions := ip.weigh(p, samples)
+	if contributions == nil {
+		return nil
+	}
+	var x, y float64
+	for _, c := range contributions {
+		x += c.Weight * math.Sin(c.Value*math.Pi/180)
+		y += c.Weight * math.Cos(c.Value*math.Pi/180)
+	}
+	value := math.Atan2(x, y) * 180 / math.Pi
+	if value < 0 {
+		value += 360
+	}
+	return &Estimate{Value: value, Contributions: contributions}
+}
+
+// weigh returns the contributions of the samples to an estimate at p with
+// normalised inverse-distance weights.
+func (ip *Interpolator) weigh(p geo.Point, samples []sample) []Contribution {
+	var contributions []Contribution
+	for _, s := range samples {
+		d := geo.Distance(p, s.point)
+		if ip.MaxDistance > 0 && d > ip.MaxDistance {
+			continue
+		}
+		contributions = append(contributions, Contribution{StationID: s.stationID, Distance: d, Value: s.value})
+	}
+	if len(contributions) == 0 {
+		return nil
+	}
+	sort.SliceStable(contributions, func(i, j int) bool {
+		return contributions[i].Distance < contributions[j].Distance
+	})
+	if ip.MaxStations > 0 && len(contributions) > ip.MaxStations {
+		contributions = contributions[:ip.MaxStations]
+	}
+
+	// Stations located at the point are the only contributions
+	if contributions[0].Distance < interpolatorCoincident {
+		n := 0
+		for n < len(contributions) && contributions[n].Distance < interpolatorCoincident {
+			contributions[n].Weight = 1
+			n++
+		}
+		contributions = contributions[:n]
+	} else {
+		for i := range contributions {
+			contributions[i].Weight = 1 / math.Pow(contributions[i].Distance, ip.Power)
+		}
+	}
+
+	// Normalise weights
+	var total float64
+	for _, c := range contributions {
+		total += c.Weight
+	}
+	for i := range contributions {
+		contributions[i].Weight /= total
+	}
+	return contributions
+}
+
+// sample represents a station reading used for interpolation.
+type sample struct {
+	stationID string
+	point     geo.Point
+	value     float64
+}
+
+// latestSamples returns the readings of the latest item of a resource
+// joined with the locations of their stations. Readings of unknown
+// stations are skipped.
+func latestSamples(r StationReadingLister) []sample {
+	locations := make(map[string]geo.Point)
+	for _, s := range r.Stations() {
+		locations[s.ID] = geo.Point{Latitude: s.Latitude, Longitude: s.Longitude}
+	}
+	var samples []sample
+	for _, reading := range latestReadings(r.StationReadings()) {
+		if p, ok := locations[reading.StationID]; ok {
+			samples = append(samples, sample{reading.StationID, p, reading.Value})
+		}
+	}
+	return samples
+}
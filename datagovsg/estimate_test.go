@@ -0,0 +1,185 @@
+package datagovsg
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// newTestAirTemperature returns an AirTemperature with stations placed
+// 0.01 degrees west and east of (1.35, 103.8), and a third station which
+// has no reading.
+func newTestAirTemperature(west, east float64) *AirTemperature {
+	return &AirTemperature{
+		Metadata: AirTemperatureMetadata{
+			Stations: []AirTemperatureMetadataStation{
+				{ID: "W", Location: AirTemperatureMetadataStationLocation{Longitude: 103.79, Latitude: 1.35}},
+				{ID: "E", Location: AirTemperatureMetadataStationLocation{Longitude: 103.81, Latitude: 1.35}},
+				{ID: "X", Location: AirTemperatureMetadataStationLocation{Longitude: 103.8, Latitude: 1.351}},
+			},
+		},
+		Items: []AirTemperatureItem{
+			{
+				Readings: []AirTemperatureItemReading{
+					{StationID: "W", Value: west},
+					{StationID: "E", Value: east},
+					{StationID: "UNKNOWN", Value: 100},
+				},
+			},
+		},
+	}
+}
+
+func TestEstimateWeather(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		ip        *Interpolator
+		want      float64
+		stations  []string
+	}{
+		{"midpoint", 1.35, 103.8, NewInterpolator(), 29, []string{"W", "E"}},
+		{"coincident", 1.35, 103.79, NewInterpolator(), 28, []string{"W"}},
+		{"quarter", 1.35, 103.795, NewInterpolator(), 28 + 2*(1.0/9)/(1+1.0/9), []string{"W", "E"}},
+		{"max_stations", 1.35, 103.805, &Interpolator{Power: 2, MaxStations: 1}, 30, []string{"E"}},
+		{"max_distance", 1.35, 103.805, &Interpolator{Power: 2, MaxDistance: 1000}, 30, []string{"E"}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			obs := &WeatherObservations{AirTemperature: newTestAirTemperature(28, 30)}
+			e, err := tc.ip.EstimateWeather(tc.latitude, tc.longitude, obs)
+			if err != nil {
+				t.Fatalf("expected no errors but got: %v", err)
+			}
+			got := e.AirTemperature
+			if got == nil {
+				t.Fatalf("expected an estimate but got nil")
+			}
+			if math.Abs(got.Value-tc.want) > 1e-3 {
+				t.Errorf("got %v want %v", got.Value, tc.want)
+			}
+			if len(got.Contributions) != len(tc.stations) {
+				t.Fatalf("got %+v want stations %v", got.Contributions, tc.stations)
+			}
+			for i, c := range got.Contributions {
+				if c.StationID != tc.stations[i] {
+					t.Errorf("got %+v want stations %v", got.Contributions, tc.stations)
+				}
+			}
+			var total float64
+			for _, c := range got.Contributions {
+				total += c.Weight
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("got total weight %v want %v", total, 1)
+			}
+		})
+	}
+}
+
+func TestEstimateWeather_WindDirection(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		west float64
+		east float64
+		want float64
+	}{
+		{"north", 350, 10, 0},
+		{"east", 45, 135, 90},
+		{"west", 200, 340, 270},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			at := newTestAirTemperature(tc.west, tc.east)
+			wd := &WindDirection{
+				Metadata: WindDirectionMetadata{
+					Stations: []WindDirectionMetadataStation{
+						{ID: "W", Location: WindDirectionMetadataStationLocation(at.Metadata.Stations[0].Location)},
+						{ID: "E", Location: WindDirectionMetadataStationLocation(at.Metadata.Stations[1].Location)},
+					},
+				},
+				Items: []WindDirectionItem{
+					{Readings: []WindDirectionItemReading{{"W", tc.west}, {"E", tc.east}}},
+				},
+			}
+			e, err := EstimateWeather(1.35, 103.8, &WeatherObservations{WindDirection: wd})
+			if err != nil {
+				t.Fatalf("expected no errors but got: %v", err)
+			}
+			got := e.WindDirection
+			if got == nil {
+				t.Fatalf("expected an estimate but got nil")
+			}
+			diff := math.Mod(math.Abs(got.Value-tc.want), 360)
+			if math.Min(diff, 360-diff) > 1e-6 {
+				t.Errorf("got %v want %v", got.Value, tc.want)
+			}
+		})
+	}
+}
+
+func TestEstimateWeather_Fixtures(t *testing.T) {
+	// Load fixtures
+	obs := &WeatherObservations{
+		AirTemperature:   &AirTemperature{},
+		RelativeHumidity: &RelativeHumidity{},
+		Rainfall:         &Rainfall{},
+		WindSpeed:        &WindSpeed{},
+		WindDirection:    &WindDirection{},
+	}
+	loadFixture(t, "testdata/fixtures/environment_airtemperature_default.json", obs.AirTemperature)
+	loadFixture(t, "testdata/fixtures/environment_relativehumidity_default.json", obs.RelativeHumidity)
+	loadFixture(t, "testdata/fixtures/environment_rainfall_default.json", obs.Rainfall)
+	loadFixture(t, "testdata/fixtures/environment_windspeed_default.json", obs.WindSpeed)
+	loadFixture(t, "testdata/fixtures/environment_winddirection_default.json", obs.WindDirection)
+
+	// Assert estimates lie within the range of contributing readings
+	e, err := EstimateWeather(1.3521, 103.8198, obs)
+	if err != nil {
+		t.Fatalf("expected no errors but got: %v", err)
+	}
+	for name, estimate := range map[string]*Estimate{
+		"air_temperature":   e.AirTemperature,
+		"relative_humidity": e.RelativeHumidity,
+		"rainfall":          e.Rainfall,
+		"wind_speed":        e.WindSpeed,
+	} {
+		if estimate == nil {
+			t.Errorf("expected %v estimate but got nil", name)
+			continue
+		}
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, c := range estimate.Contributions {
+			min, max = math.Min(min, c.Value), math.Max(max, c.Value)
+		}
+		if estimate.Value < min || estimate.Value > max {
+			t.Errorf("got %v estimate %v outside [%v, %v]", name, estimate.Value, min, max)
+		}
+	}
+	if e.WindDirection == nil || e.WindDirection.Value < 0 || e.WindDirection.Value >= 360 {
+		t.Errorf("got wind direction estimate %+v", e.WindDirection)
+	}
+
+	// Assert missing observations are omitted
+	if e, err := EstimateWeather(1.3521, 103.8198, &WeatherObservations{}); err != nil || e.AirTemperature != nil {
+		t.Errorf("expected no estimate but got: %+v, %v", e, err)
+	}
+
+	// Assert nil observations are an error
+	if _, err := EstimateWeather(1.3521, 103.8198, nil); !errors.Is(err, ErrNoObservations) {
+		t.Errorf("expected error '%v' but got: %v", ErrNoObservations, err)
+	}
+}
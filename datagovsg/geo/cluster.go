@@ -0,0 +1,112 @@
+package geo
+
+import "sort"
+
+// DBSCAN groups points that are closely packed together using the
+// DBSCAN algorithm.
+//
+// A point with at least minPoints points, including itself, within eps
+// metres is a core point. Clusters are formed by the core points reachable
+// from each other together with their neighbors. The indices of the points
+// of each cluster are returned in ascending order and points belonging to
+// no cluster are omitted.
+func DBSCAN(points []Point, eps float64, minPoints int) [][]int {
+	g := NewGrid(eps)
+	g.Reset(points)
+
+	const (
+		unvisited = 0
+		noise     = -1
+	)
+	labels := make([]int, len(points))
+	var clusters [][]int
+	var neighbors []Neighbor
+	for i := range points {
+		if labels[i] != unvisited {
+			continue
+		}
+		neighbors = g.AppendWithin(neighbors[:0], points[i], eps)
+		if len(neighbors) < minPoints {
+			labels[i] = noise
+			continue
+		}
+
+		// Expand the cluster from the core point
+		label := len(clusters) + 1
+		labels[i] = label
+		members := []int{i}
+		queue := make([]int, 0, len(neighbors))
+		for _, n := range neighbors {
+			queue = append(queue, n.Index)
+		}
+		for len(queue) > 0 {
+			j := queue[0]
+			queue = queue[1:]
+			if labels[j] == noise {
+				labels[j] = label
+				members = append(members, j)
+			}
+			if labels[j] != unvisited {
+				continue
+			}
+			labels[j] = label
+			members = append(members, j)
+			neighbors = g.AppendWithin(neighbors[:0], points[j], eps)
+			if len(neighbors) >= minPoints {
+				for _, n := range neighbors {
+					if labels[n.Index] == unvisited || labels[n.Index] == noise {
+						queue = append(queue, n.Index)
+					}
+				}
+			}
+		}
+		sort.Ints(members)
+		clusters = append(clusters, members)
+	}
+	return clusters
+}
+
+// ConvexHull returns the convex hull of the points as a counter-clockwise
+// ring, treating longitude and latitude as planar coordinates. Fewer than
+// three distinct points are returned as is.
+func ConvexHull(points []Point) Ring {
+	s := append([]Point(nil), points...)
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].Longitude != s[j].Longitude {
+			return s[i].Longitude < s[j].Longitude
+		}
+		return s[i].Latitude < s[j].Latitude
+	})
+
+	// Remove duplicate points
+	unique := s[:0]
+	for i, p := range s {
+		if i == 0 || p != s[i-1] {
+			unique = append(unique, p)
+		}
+	}
+	s = unique
+	if len(s) < 3 {
+		return Ring(s)
+	}
+
+	// Build the lower and upper hulls using Andrew's monotone chain
+	cross := func(o, a, b Point) float64 {
+		return (a.Longitude-o.Longitude)*(b.Latitude-o.Latitude) - (a.Latitude-o.Latitude)*(b.Longitude-o.Longitude)
+	}
+	hull := make(Ring, 0, 2*len(s))
+	for _, p := range s {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(s) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], s[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, s[i])
+	}
+	return hull[:len(hull)-1]
+}
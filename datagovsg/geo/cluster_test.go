@@ -0,0 +1,127 @@
+package geo
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// offset returns the point moved north and east by the given distances
+// in metres.
+func offset(p Point, north, east float64) Point {
+	return Point{p.Latitude + north/metresPerDegree, p.Longitude + east/metresPerDegree}
+}
+
+func TestDBSCAN(t *testing.T) {
+	a, b := Point{1.30, 103.80}, Point{1.35, 103.90}
+	points := []Point{
+		a, offset(a, 50, 0), offset(a, 0, 50), offset(a, 100, 0), // cluster around a
+		b, offset(b, 30, 30), offset(b, -30, 30), // cluster around b
+		offset(a, 100, 90),                // border point of a
+		Point{1.40, 103.70},               // noise
+		offset(a, 1000, 0),                // noise
+		offset(b, 0, -60),                 // member of b
+		offset(Point{1.4, 103.7}, 0, 200), // noise
+	}
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		eps       float64
+		minPoints int
+		want      [][]int
+	}{
+		{"default", 100, 3, [][]int{{0, 1, 2, 3, 7}, {4, 5, 6, 10}}},
+		{"strict", 60, 4, [][]int{{4, 5, 6, 10}}},
+		{"everything", 100000, 1, [][]int{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}}},
+		{"none", 10, 2, nil},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := DBSCAN(points, tc.eps, tc.minPoints); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDBSCAN_Random(t *testing.T) {
+	// Every cluster member must be within eps of a core point in the
+	// same cluster, and every core point must be in a cluster
+	const eps, minPoints = 500, 4
+	points := randomPoints(rand.New(rand.NewSource(1)), 2000)
+	clusters := DBSCAN(points, eps, minPoints)
+	label := make(map[int]int)
+	for c, members := range clusters {
+		for _, i := range members {
+			if _, ok := label[i]; ok {
+				t.Fatalf("got point %v in multiple clusters", i)
+			}
+			label[i] = c
+		}
+	}
+	cores := make([]bool, len(points))
+	for i, p := range points {
+		n := 0
+		for _, q := range points {
+			if Distance(p, q) <= eps {
+				n++
+			}
+		}
+		cores[i] = n >= minPoints
+	}
+	core := func(i int) bool {
+		return cores[i]
+	}
+	for i := range points {
+		c, ok := label[i]
+		if core(i) && !ok {
+			t.Errorf("got core point %v in no cluster", i)
+		}
+		if !ok || core(i) {
+			continue
+		}
+		reachable := false
+		for _, j := range clusters[c] {
+			if core(j) && Distance(points[i], points[j]) <= eps {
+				reachable = true
+				break
+			}
+		}
+		if !reachable {
+			t.Errorf("got border point %v not reachable from a core point", i)
+		}
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name   string
+		points []Point
+		want   Ring
+	}{
+		{"empty", nil, nil},
+		{"single", []Point{{1, 1}}, Ring{{1, 1}}},
+		{"duplicates", []Point{{1, 1}, {1, 1}, {2, 2}}, Ring{{1, 1}, {2, 2}}},
+		{"square_with_interior", []Point{{0, 0}, {1, 1}, {2, 0}, {0, 2}, {2, 2}, {1, 0}}, Ring{{0, 0}, {0, 2}, {2, 2}, {2, 0}}},
+		{"collinear", []Point{{0, 0}, {0, 1}, {0, 2}}, Ring{{0, 0}, {0, 2}}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ConvexHull(tc.points); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package geo provides geospatial utilities for working with the locations
+// published in Data.gov.sg datasets.
+package geo
+
+import "math"
+
+const (
+	// Mean radius of the Earth in metres.
+	EarthRadius = 6371008.8
+
+	// Radians per degree.
+	radians = math.Pi / 180
+)
+
+// Point represents a geographical coordinate in WGS84.
+type Point struct {
+	// Latitude in degrees
+	Latitude float64
+
+	// Longitude in degrees
+	Longitude float64
+}
+
+// Distance returns the great-circle distance in metres between two points
+// using the haversine formula.
+func Distance(a, b Point) float64 {
+	phi1 := a.Latitude * radians
+	phi2 := b.Latitude * radians
+	dphi := (b.Latitude - a.Latitude) * radians
+	dlambda := (b.Longitude - a.Longitude) * radians
+
+	h := math.Sin(dphi/2)*math.Sin(dphi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dlambda/2)*math.Sin(dlambda/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// cartesian returns the coordinates of a point on the unit sphere.
+func cartesian(p Point) [3]float64 {
+	phi, lambda := p.Latitude*radians, p.Longitude*radians
+	return [3]float64{
+		math.Cos(phi) * math.Cos(lambda),
+		math.Cos(phi) * math.Sin(lambda),
+		math.Sin(phi),
+	}
+}
+
+// chordToDistance converts the squared chord length between two points on
+// the unit sphere into the great-circle distance in metres.
+func chordToDistance(chord2 float64) float64 {
+	return 2 * EarthRadius * math.Asin(math.Min(math.Sqrt(chord2)/2, 1))
+}
+
+// distanceToChord converts a great-circle distance in metres into the
+// squared chord length between two points on the unit sphere.
+func distanceToChord(d float64) float64 {
+	if d >= math.Pi*EarthRadius {
+		return 4
+	}
+	c := 2 * math.Sin(d/(2*EarthRadius))
+	return c * c
+}
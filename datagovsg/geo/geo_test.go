@@ -0,0 +1,37 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		a    Point
+		b    Point
+		want float64
+	}{
+		{"same_point", Point{1.3521, 103.8198}, Point{1.3521, 103.8198}, 0},
+		{"one_degree_latitude", Point{1, 103.8}, Point{2, 103.8}, 111195.08},
+		{"one_degree_longitude_equator", Point{0, 103}, Point{0, 104}, 111195.08},
+		{"clementi_to_changi", Point{1.3337, 103.7768}, Point{1.3678, 103.9826}, 23189.68},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Distance(tc.a, tc.b)
+			if math.Abs(got-tc.want) > 0.01 {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+			if back := Distance(tc.b, tc.a); math.Abs(back-got) > 1e-9 {
+				t.Errorf("expected symmetric distance but got %v and %v", got, back)
+			}
+		})
+	}
+}
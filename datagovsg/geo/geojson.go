@@ -0,0 +1,253 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// GeoJSON geometry types.
+const (
+	GeometryPoint        = "Point"
+	GeometryMultiPoint   = "MultiPoint"
+	GeometryLineString   = "LineString"
+	GeometryPolygon      = "Polygon"
+	GeometryMultiPolygon = "MultiPolygon"
+)
+
+var (
+	// ErrGeometryType is returned when a geometry is not of the expected
+	// type.
+	ErrGeometryType = errors.New("geo: unexpected geometry type")
+)
+
+// Geometry corresponds to a GeoJSON Geometry object as defined in RFC 7946.
+// Positions are given as longitude and latitude pairs.
+type Geometry struct {
+	// Type of the geometry, e.g. "Point"
+	Type string `json:"type"`
+
+	// Coordinates of the geometry
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// newGeometry returns a geometry with the coordinates encoded as JSON.
+func newGeometry(typ string, coordinates interface{}) *Geometry {
+	b, _ := json.Marshal(coordinates)
+	return &Geometry{Type: typ, Coordinates: b}
+}
+
+// position returns the GeoJSON position of a point.
+func position(p Point) [2]float64 {
+	return [2]float64{p.Longitude, p.Latitude}
+}
+
+// positions returns the GeoJSON positions of the points.
+func positions(points []Point) [][2]float64 {
+	s := make([][2]float64, len(points))
+	for i, p := range points {
+		s[i] = position(p)
+	}
+	return s
+}
+
+// NewPointGeometry returns a Point geometry.
+func NewPointGeometry(p Point) *Geometry {
+	return newGeometry(GeometryPoint, position(p))
+}
+
+// NewMultiPointGeometry returns a MultiPoint geometry.
+func NewMultiPointGeometry(points []Point) *Geometry {
+	return newGeometry(GeometryMultiPoint, positions(points))
+}
+
+// NewLineStringGeometry returns a LineString geometry.
+func NewLineStringGeometry(points []Point) *Geometry {
+	return newGeometry(GeometryLineString, positions(points))
+}
+
+// NewPolygonGeometry returns a Polygon geometry. Rings are closed and
+// wound following the right-hand rule, counter-clockwise for the outer
+// ring and clockwise for holes.
+func NewPolygonGeometry(p Polygon) *Geometry {
+	return newGeometry(GeometryPolygon, polygonPositions(p))
+}
+
+// NewMultiPolygonGeometry returns a MultiPolygon geometry. Rings are
+// closed and wound following the right-hand rule.
+func NewMultiPolygonGeometry(m MultiPolygon) *Geometry {
+	s := make([][][][2]float64, len(m))
+	for i, p := range m {
+		s[i] = polygonPositions(p)
+	}
+	return newGeometry(GeometryMultiPolygon, s)
+}
+
+// polygonPositions returns the GeoJSON positions of the rings of a
+// polygon.
+func polygonPositions(p Polygon) [][][2]float64 {
+	s := make([][][2]float64, len(p))
+	for i, r := range p {
+		// Close the ring
+		if len(r) > 0 && r[0] != r[len(r)-1] {
+			r = append(append(Ring(nil), r...), r[0])
+		}
+		s[i] = positions(r)
+
+		// Wind outer rings counter-clockwise and holes clockwise
+		if ccw := signedArea(r) > 0; ccw != (i == 0) {
+			for a, b := 0, len(s[i])-1; a < b; a, b = a+1, b-1 {
+				s[i][a], s[i][b] = s[i][b], s[i][a]
+			}
+		}
+	}
+	return s
+}
+
+// signedArea returns twice the signed area of the ring in the longitude
+// and latitude plane, which is positive for counter-clockwise rings.
+func signedArea(r Ring) float64 {
+	var a float64
+	for i := range r {
+		p, q := r[i], r[(i+1)%len(r)]
+		a += p.Longitude*q.Latitude - q.Longitude*p.Latitude
+	}
+	return a
+}
+
+// Point returns the point of a Point geometry.
+func (g *Geometry) Point() (Point, error) {
+	if g.Type != GeometryPoint {
+		return Point{}, fmt.Errorf("%w: %v", ErrGeometryType, g.Type)
+	}
+	var c []float64
+	if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+		return Point{}, err
+	}
+	if len(c) < 2 {
+		return Point{}, fmt.Errorf("geo: invalid position %v", c)
+	}
+	return Point{Latitude: c[1], Longitude: c[0]}, nil
+}
+
+// MultiPolygon returns the polygons of a Polygon or MultiPolygon geometry.
+// Altitudes are discarded.
+func (g *Geometry) MultiPolygon() (MultiPolygon, error) {
+	switch g.Type {
+	case GeometryPolygon:
+		var c [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return nil, err
+		}
+		return MultiPolygon{polygonFromPositions(c)}, nil
+	case GeometryMultiPolygon:
+		var c [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return nil, err
+		}
+		m := make(MultiPolygon, len(c))
+		for i, p := range c {
+			m[i] = polygonFromPositions(p)
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrGeometryType, g.Type)
+	}
+}
+
+// polygonFromPositions returns the polygon with the given GeoJSON
+// positions.
+func polygonFromPositions(c [][][]float64) Polygon {
+	p := make(Polygon, len(c))
+	for i, ring := range c {
+		p[i] = make(Ring, 0, len(ring))
+		for _, pos := range ring {
+			if len(pos) < 2 {
+				continue
+			}
+			p[i] = append(p[i], Point{Latitude: pos[1], Longitude: pos[0]})
+		}
+	}
+	return p
+}
+
+// Feature corresponds to a GeoJSON Feature object as defined in RFC 7946.
+type Feature struct {
+	// Type of the object, always "Feature"
+	Type string `json:"type"`
+
+	// Identifier of the feature
+	ID FeatureID `json:"id,omitempty"`
+
+	// Geometry of the feature
+	Geometry *Geometry `json:"geometry"`
+
+	// Properties of the feature
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// FeatureID is the identifier of a feature. RFC 7946 allows identifiers
+// to be either strings or numbers, so numbers are decoded as their literal
+// text, e.g. 42 as "42". Identifiers are always encoded as strings.
+type FeatureID string
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (id *FeatureID) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case string:
+		*id = FeatureID(v)
+	case json.Number:
+		*id = FeatureID(v)
+	case nil:
+		*id = ""
+	default:
+		return fmt.Errorf("geo: invalid feature id %s", b)
+	}
+	return nil
+}
+
+// NewFeature returns a new Feature.
+func NewFeature(id string, geometry *Geometry, properties map[string]interface{}) Feature {
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return Feature{
+		Type:       "Feature",
+		ID:         FeatureID(id),
+		Geometry:   geometry,
+		Properties: properties,
+	}
+}
+
+// FeatureCollection corresponds to a GeoJSON FeatureCollection object as
+// defined in RFC 7946.
+type FeatureCollection struct {
+	// Type of the object, always "FeatureCollection"
+	Type string `json:"type"`
+
+	// Features in the collection
+	Features []Feature `json:"features"`
+}
+
+// NewFeatureCollection returns a new FeatureCollection.
+func NewFeatureCollection(features ...Feature) *FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return &FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: features,
+	}
+}
+
+// Add appends features to the collection.
+func (fc *FeatureCollection) Add(features ...Feature) {
+	fc.Features = append(fc.Features, features...)
+}
@@ -0,0 +1,148 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGeometry_JSON(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name     string
+		geometry *Geometry
+		want     string
+	}{
+		{
+			"point",
+			NewPointGeometry(Point{1.3, 103.8}),
+			`{"type":"Point","coordinates":[103.8,1.3]}`,
+		},
+		{
+			"multipoint",
+			NewMultiPointGeometry([]Point{{1.3, 103.8}, {1.4, 103.9}}),
+			`{"type":"MultiPoint","coordinates":[[103.8,1.3],[103.9,1.4]]}`,
+		},
+		{
+			"linestring",
+			NewLineStringGeometry([]Point{{1.3, 103.8}, {1.4, 103.9}}),
+			`{"type":"LineString","coordinates":[[103.8,1.3],[103.9,1.4]]}`,
+		},
+		{
+			"polygon_closed_and_wound",
+			NewPolygonGeometry(Polygon{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+				{{0.2, 0.2}, {0.2, 0.8}, {0.8, 0.8}, {0.8, 0.2}},
+			}),
+			`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]],[[0.2,0.2],[0.2,0.8],[0.8,0.8],[0.8,0.2],[0.2,0.2]]]}`,
+		},
+		{
+			"multipolygon",
+			NewMultiPolygonGeometry(MultiPolygon{{{{0, 0}, {0, 1}, {1, 1}, {0, 0}}}}),
+			`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}`,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(tc.geometry)
+			if err != nil {
+				t.Fatalf("error marshalling geometry: %v", err)
+			}
+			if got := string(b); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_MultiPolygon(t *testing.T) {
+	want := MultiPolygon{{{{0, 0}, {0, 1}, {1, 1}, {0, 0}}}}
+	for _, g := range []*Geometry{NewPolygonGeometry(want[0]), NewMultiPolygonGeometry(want)} {
+		got, err := g.MultiPolygon()
+		if err != nil {
+			t.Fatalf("error decoding %v: %v", g.Type, err)
+		}
+		// Winding may be reversed, so compare the set of vertices
+		if len(got) != 1 || len(got[0]) != 1 || len(got[0][0]) != 4 || !got[0].Contains(Point{0.2, 0.5}) {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	}
+	if _, err := NewPointGeometry(Point{}).MultiPolygon(); !errors.Is(err, ErrGeometryType) {
+		t.Errorf("got %v want %v", err, ErrGeometryType)
+	}
+}
+
+func TestGeometry_Point(t *testing.T) {
+	want := Point{1.3, 103.8}
+	got, err := NewPointGeometry(want).Point()
+	if err != nil || got != want {
+		t.Errorf("got (%+v, %v) want (%+v, %v)", got, err, want, nil)
+	}
+	if _, err := NewMultiPointGeometry(nil).Point(); !errors.Is(err, ErrGeometryType) {
+		t.Errorf("got %v want %v", err, ErrGeometryType)
+	}
+}
+
+func TestFeatureCollection_JSON(t *testing.T) {
+	fc := NewFeatureCollection()
+	b, _ := json.Marshal(fc)
+	if got, want := string(b), `{"type":"FeatureCollection","features":[]}`; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	fc.Add(NewFeature("S24", NewPointGeometry(Point{1.3, 103.8}), map[string]interface{}{"name": "Changi"}))
+	b, _ = json.Marshal(fc)
+	var got FeatureCollection
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("error unmarshalling feature collection: %v", err)
+	}
+	if !reflect.DeepEqual(&got, fc) {
+		t.Errorf("got %+v want %+v", got, fc)
+	}
+}
+
+func TestFeature_ID(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		json string
+		want FeatureID
+		err  bool
+	}{
+		{"string", `{"type":"Feature","id":"S24"}`, "S24", false},
+		{"integer", `{"type":"Feature","id":42}`, "42", false},
+		{"decimal", `{"type":"Feature","id":1.5e3}`, "1.5e3", false},
+		{"null", `{"type":"Feature","id":null}`, "", false},
+		{"missing", `{"type":"Feature"}`, "", false},
+		{"object", `{"type":"Feature","id":{}}`, "", true},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var f Feature
+			err := json.Unmarshal([]byte(tc.json), &f)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected error but got: %+v", f)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error unmarshalling feature: %v", err)
+			}
+			if f.ID != tc.want {
+				t.Errorf("got %q want %q", f.ID, tc.want)
+			}
+		})
+	}
+}
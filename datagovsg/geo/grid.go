@@ -0,0 +1,302 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// The default size in metres of the cells of a Grid.
+	defaultGridCellSize = 250
+
+	// Metres per degree of latitude.
+	metresPerDegree = EarthRadius * radians
+)
+
+// Grid is a uniform grid over a set of points supporting within-radius,
+// bounding box and k-nearest queries.
+//
+// Unlike Index, a Grid is meant to be rebuilt frequently: Reset reuses the
+// memory of the previous build, so a single Grid can index a stream of
+// snapshots of similar size without allocating.
+type Grid struct {
+	cellSize float64
+	points   []Point
+	origin   Point
+	dlat     float64
+	dlon     float64
+	nx       int
+	ny       int
+	start    []int
+	order    []int
+}
+
+// NewGrid returns a new empty Grid with cells of approximately the given
+// size in metres. A non-positive size uses the default of 250 metres.
+func NewGrid(cellSize float64) *Grid {
+	if cellSize <= 0 {
+		cellSize = defaultGridCellSize
+	}
+	return &Grid{cellSize: cellSize}
+}
+
+// Reset replaces the points in the grid.
+func (g *Grid) Reset(points []Point) {
+	g.points = append(g.points[:0], points...)
+	g.nx, g.ny = 0, 0
+	g.start = append(g.start[:0], 0)
+	g.order = g.order[:0]
+	if len(points) == 0 {
+		return
+	}
+
+	// Size the cells from the extent of the points, growing them if the
+	// points are so spread out that the grid would be mostly empty
+	b := EmptyBBox()
+	for _, p := range points {
+		b = b.Extend(p)
+	}
+	g.origin = b.Min
+	g.dlat = g.cellSize / metresPerDegree
+	g.dlon = g.dlat / math.Max(math.Cos(math.Max(math.Abs(b.Min.Latitude), math.Abs(b.Max.Latitude))*radians), 1e-6)
+	for {
+		g.nx = int((b.Max.Longitude-b.Min.Longitude)/g.dlon) + 1
+		g.ny = int((b.Max.Latitude-b.Min.Latitude)/g.dlat) + 1
+		if g.nx*g.ny <= 4*len(points)+64 {
+			break
+		}
+		g.dlat *= 2
+		g.dlon *= 2
+	}
+
+	// Sort the points by cell
+	n := g.nx * g.ny
+	if cap(g.start) < n+1 {
+		g.start = make([]int, n+1)
+	}
+	g.start = g.start[:n+1]
+	for i := range g.start {
+		g.start[i] = 0
+	}
+	for _, p := range g.points {
+		g.start[g.cell(p)+1]++
+	}
+	for c := 1; c <= n; c++ {
+		g.start[c] += g.start[c-1]
+	}
+	if cap(g.order) < len(g.points) {
+		g.order = make([]int, len(g.points))
+	}
+	g.order = g.order[:len(g.points)]
+	for i, p := range g.points {
+		c := g.cell(p)
+		g.order[g.start[c]] = i
+		g.start[c]++
+	}
+	for c := n; c > 0; c-- {
+		g.start[c] = g.start[c-1]
+	}
+	g.start[0] = 0
+}
+
+// Len returns the number of points in the grid.
+func (g *Grid) Len() int {
+	return len(g.points)
+}
+
+// Point returns the point at the given index.
+func (g *Grid) Point(i int) Point {
+	return g.points[i]
+}
+
+// column returns the column of the grid containing a longitude, clamped
+// to the grid.
+func (g *Grid) column(lon float64) int {
+	return clamp(int(math.Floor((lon-g.origin.Longitude)/g.dlon)), 0, g.nx-1)
+}
+
+// row returns the row of the grid containing a latitude, clamped to the
+// grid.
+func (g *Grid) row(lat float64) int {
+	return clamp(int(math.Floor((lat-g.origin.Latitude)/g.dlat)), 0, g.ny-1)
+}
+
+// cell returns the index of the cell containing a point.
+func (g *Grid) cell(p Point) int {
+	return g.row(p.Latitude)*g.nx + g.column(p.Longitude)
+}
+
+// visit calls fn with the index of every point in the cells within the
+// given inclusive rows and columns.
+func (g *Grid) visit(y0, y1, x0, x1 int, fn func(i int)) {
+	for y := y0; y <= y1; y++ {
+		row := y * g.nx
+		for _, i := range g.order[g.start[row+x0]:g.start[row+x1+1]] {
+			fn(i)
+		}
+	}
+}
+
+// bounds returns the rows and columns of the cells that may contain
+// points within the radius of p.
+func (g *Grid) bounds(p Point, radius float64) (y0, y1, x0, x1 int) {
+	dlat := radius / metresPerDegree
+	y0, y1 = g.row(p.Latitude-dlat), g.row(p.Latitude+dlat)
+	x0, x1 = 0, g.nx-1
+	if s := math.Sin(math.Min(radius/EarthRadius, math.Pi/2)) / math.Cos(p.Latitude*radians); s < 1 && math.Abs(p.Latitude)+dlat < 90 {
+		dlon := math.Asin(s) / radians
+		x0, x1 = g.column(p.Longitude-dlon), g.column(p.Longitude+dlon)
+	}
+	return y0, y1, x0, x1
+}
+
+// Within returns the points within the radius in metres of p, ordered by
+// increasing distance.
+func (g *Grid) Within(p Point, radius float64) []Neighbor {
+	neighbors := g.AppendWithin(nil, p, radius)
+	sort.Slice(neighbors, func(i, j int) bool {
+		return neighbors[i].Distance < neighbors[j].Distance
+	})
+	return neighbors
+}
+
+// AppendWithin appends the points within the radius in metres of p to dst
+// in no particular order and returns the extended slice.
+func (g *Grid) AppendWithin(dst []Neighbor, p Point, radius float64) []Neighbor {
+	if len(g.points) == 0 || radius < 0 {
+		return dst
+	}
+	y0, y1, x0, x1 := g.bounds(p, radius)
+	g.visit(y0, y1, x0, x1, func(i int) {
+		if d := Distance(p, g.points[i]); d <= radius {
+			dst = append(dst, Neighbor{i, d})
+		}
+	})
+	return dst
+}
+
+// Count returns the number of points within the radius in metres of p.
+func (g *Grid) Count(p Point, radius float64) int {
+	if len(g.points) == 0 || radius < 0 {
+		return 0
+	}
+	var n int
+	y0, y1, x0, x1 := g.bounds(p, radius)
+	g.visit(y0, y1, x0, x1, func(i int) {
+		if Distance(p, g.points[i]) <= radius {
+			n++
+		}
+	})
+	return n
+}
+
+// InBBox returns the indices of the points within the bounding box in
+// ascending order.
+func (g *Grid) InBBox(b BBox) []int {
+	if len(g.points) == 0 || b.Empty() {
+		return nil
+	}
+	var indices []int
+	y0, y1 := g.row(b.Min.Latitude), g.row(b.Max.Latitude)
+	x0, x1 := g.column(b.Min.Longitude), g.column(b.Max.Longitude)
+	g.visit(y0, y1, x0, x1, func(i int) {
+		if b.Contains(g.points[i]) {
+			indices = append(indices, i)
+		}
+	})
+	sort.Ints(indices)
+	return indices
+}
+
+// Nearest returns up to k points nearest to p, ordered by increasing
+// distance.
+//
+// Cells are searched in square rings of increasing size around the cell
+// containing p until the k-th nearest candidate is closer than any
+// unsearched cell.
+func (g *Grid) Nearest(p Point, k int) []Neighbor {
+	if k <= 0 || len(g.points) == 0 {
+		return nil
+	}
+	var candidates []Neighbor
+	add := func(i int) {
+		candidates = append(candidates, Neighbor{i, Distance(p, g.points[i])})
+	}
+	cy, cx := g.row(p.Latitude), g.column(p.Longitude)
+	for n := 0; ; n++ {
+		y0, y1, x0, x1 := cy-n, cy+n, cx-n, cx+n
+
+		// Visit the cells on the ring
+		if y0 >= 0 {
+			g.visit(y0, y0, clamp(x0, 0, g.nx-1), clamp(x1, 0, g.nx-1), add)
+		}
+		if y1 < g.ny && n > 0 {
+			g.visit(y1, y1, clamp(x0, 0, g.nx-1), clamp(x1, 0, g.nx-1), add)
+		}
+		if r0, r1 := clamp(y0+1, 0, g.ny-1), clamp(y1-1, 0, g.ny-1); n > 0 && r0 <= r1 {
+			if x0 >= 0 {
+				g.visit(r0, r1, x0, x0, add)
+			}
+			if x1 < g.nx {
+				g.visit(r0, r1, x1, x1, add)
+			}
+		}
+
+		// Stop once the unsearched cells cannot hold a nearer point
+		full := y0 <= 0 && x0 <= 0 && y1 >= g.ny-1 && x1 >= g.nx-1
+		if len(candidates) >= k {
+			sort.Slice(candidates, func(i, j int) bool {
+				return candidates[i].Distance < candidates[j].Distance
+			})
+			if full || candidates[k-1].Distance <= g.searched(p, y0, y1, x0, x1) {
+				return candidates[:k]
+			}
+		} else if full {
+			sort.Slice(candidates, func(i, j int) bool {
+				return candidates[i].Distance < candidates[j].Distance
+			})
+			return candidates
+		}
+	}
+}
+
+// searched returns the distance in metres from p within which all points
+// lie in the given rows and columns of cells.
+func (g *Grid) searched(p Point, y0, y1, x0, x1 int) float64 {
+	d := math.Inf(1)
+	if y0 > 0 {
+		d = math.Min(d, math.Max(p.Latitude-(g.origin.Latitude+float64(y0)*g.dlat), 0)*metresPerDegree)
+	}
+	if y1 < g.ny-1 {
+		d = math.Min(d, math.Max(g.origin.Latitude+float64(y1+1)*g.dlat-p.Latitude, 0)*metresPerDegree)
+	}
+	if x0 > 0 {
+		d = math.Min(d, meridianDistance(p, p.Longitude-(g.origin.Longitude+float64(x0)*g.dlon)))
+	}
+	if x1 < g.nx-1 {
+		d = math.Min(d, meridianDistance(p, g.origin.Longitude+float64(x1+1)*g.dlon-p.Longitude))
+	}
+	return d
+}
+
+// meridianDistance returns the great-circle distance in metres from p to
+// the meridian the given degrees of longitude away, or zero if p is on
+// the other side of the meridian.
+func meridianDistance(p Point, dlon float64) float64 {
+	if dlon <= 0 {
+		return 0
+	}
+	dlambda := math.Min(dlon*radians, math.Pi/2)
+	return EarthRadius * math.Asin(math.Cos(p.Latitude*radians)*math.Sin(dlambda))
+}
+
+// clamp returns v clamped to the range [lo, hi].
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,140 @@
+package geo
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestGrid_Nearest(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		n    int
+		k    int
+		p    Point
+	}{
+		{"empty", 0, 3, Point{1.35, 103.8}},
+		{"single", 1, 3, Point{1.35, 103.8}},
+		{"k_zero", 100, 0, Point{1.35, 103.8}},
+		{"k_one", 100, 1, Point{1.35, 103.8}},
+		{"k_five", 1000, 5, Point{1.35, 103.8}},
+		{"k_exceeds_points", 10, 20, Point{1.35, 103.8}},
+		{"outside_grid", 1000, 5, Point{1.6, 104.2}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			points := randomPoints(rand.New(rand.NewSource(1)), tc.n)
+			g := NewGrid(0)
+			g.Reset(points)
+			want := bruteForce(points, tc.p)
+			if len(want) > tc.k {
+				want = want[:tc.k]
+			}
+			assertNeighbors(t, g.Nearest(tc.p, tc.k), want)
+		})
+	}
+}
+
+func TestGrid_Nearest_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	points := randomPoints(r, 5000)
+	g := NewGrid(100)
+	g.Reset(points)
+	for _, p := range randomPoints(r, 200) {
+		assertNeighbors(t, g.Nearest(p, 7), bruteForce(points, p)[:7])
+	}
+}
+
+func TestGrid_Within(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	points := randomPoints(r, 5000)
+	g := NewGrid(0)
+	g.Reset(points)
+	for _, radius := range []float64{0, 100, 500, 2000, 50000} {
+		for _, p := range randomPoints(r, 50) {
+			var want []Neighbor
+			for _, n := range bruteForce(points, p) {
+				if n.Distance <= radius {
+					want = append(want, n)
+				}
+			}
+			assertNeighbors(t, g.Within(p, radius), want)
+			if got := g.Count(p, radius); got != len(want) {
+				t.Errorf("got %v want %v", got, len(want))
+			}
+		}
+	}
+}
+
+func TestGrid_InBBox(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	points := randomPoints(r, 2000)
+	g := NewGrid(0)
+	g.Reset(points)
+	b := BBox{Min: Point{1.3, 103.8}, Max: Point{1.35, 103.9}}
+	var want []int
+	for i, p := range points {
+		if b.Contains(p) {
+			want = append(want, i)
+		}
+	}
+	got := g.InBBox(b)
+	if !sort.IntsAreSorted(got) || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if got := g.InBBox(EmptyBBox()); got != nil {
+		t.Errorf("got %v want %v", got, nil)
+	}
+}
+
+func TestGrid_Reset(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	first, second := randomPoints(r, 3000), randomPoints(r, 3000)
+	g := NewGrid(0)
+	g.Reset(first)
+	g.Reset(second)
+	if got := g.Len(); got != len(second) {
+		t.Errorf("got %v want %v", got, len(second))
+	}
+	assertNeighbors(t, g.Nearest(second[0], 1), []Neighbor{{0, 0}})
+
+	// Reindexing a snapshot of the same size should not allocate
+	if allocs := testing.AllocsPerRun(10, func() { g.Reset(first) }); allocs != 0 {
+		t.Errorf("got %v allocations want %v", allocs, 0)
+	}
+
+	// Reindexing no points should empty the grid
+	g.Reset(nil)
+	if got := g.Nearest(Point{1.35, 103.8}, 1); got != nil {
+		t.Errorf("got %v want %v", got, nil)
+	}
+}
+
+func BenchmarkGrid_Count(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	g := NewGrid(0)
+	g.Reset(randomPoints(r, 5000))
+	queries := randomPoints(r, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Count(queries[i%len(queries)], 500)
+	}
+}
+
+func BenchmarkGrid_Nearest(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	g := NewGrid(0)
+	g.Reset(randomPoints(r, 5000))
+	queries := randomPoints(r, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Nearest(queries[i%len(queries)], 5)
+	}
+}
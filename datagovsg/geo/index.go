@@ -0,0 +1,187 @@
+package geo
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Neighbor represents a point found by a spatial query.
+type Neighbor struct {
+	// Index of the point in the slice used to build the index
+	Index int
+
+	// Great-circle distance to the query point in metres
+	Distance float64
+}
+
+// Index is a static k-d tree over a set of points supporting k-nearest
+// and within-radius queries.
+//
+// Points are indexed by their position on the unit sphere, where the
+// straight-line distance between two points increases monotonically
+// with their great-circle distance, so query results are exact.
+type Index struct {
+	xyz  [][3]float64
+	perm []int
+	axis []uint8
+}
+
+// NewIndex returns a new Index over the points.
+func NewIndex(points []Point) *Index {
+	ix := &Index{
+		xyz:  make([][3]float64, len(points)),
+		perm: make([]int, len(points)),
+		axis: make([]uint8, len(points)),
+	}
+	for i, p := range points {
+		ix.xyz[i] = cartesian(p)
+		ix.perm[i] = i
+	}
+	ix.build(0, len(points))
+	return ix
+}
+
+// Len returns the number of points in the index.
+func (ix *Index) Len() int {
+	return len(ix.perm)
+}
+
+// build recursively partitions perm[lo:hi] around its median along the
+// axis with the largest spread.
+func (ix *Index) build(lo, hi int) {
+	if hi-lo <= 1 {
+		return
+	}
+
+	// Find axis with the largest spread
+	var min, max [3]float64
+	min, max = ix.xyz[ix.perm[lo]], ix.xyz[ix.perm[lo]]
+	for _, i := range ix.perm[lo+1 : hi] {
+		for a := 0; a < 3; a++ {
+			if v := ix.xyz[i][a]; v < min[a] {
+				min[a] = v
+			} else if v > max[a] {
+				max[a] = v
+			}
+		}
+	}
+	var axis uint8
+	for a := uint8(1); a < 3; a++ {
+		if max[a]-min[a] > max[axis]-min[axis] {
+			axis = a
+		}
+	}
+
+	// Partition around the median
+	s := ix.perm[lo:hi]
+	sort.Slice(s, func(i, j int) bool {
+		return ix.xyz[s[i]][axis] < ix.xyz[s[j]][axis]
+	})
+	mid := (lo + hi) / 2
+	ix.axis[mid] = axis
+	ix.build(lo, mid)
+	ix.build(mid+1, hi)
+}
+
+// chord2 returns the squared chord length between a query and an indexed point.
+func (ix *Index) chord2(q [3]float64, i int) float64 {
+	dx, dy, dz := q[0]-ix.xyz[i][0], q[1]-ix.xyz[i][1], q[2]-ix.xyz[i][2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// Nearest returns the k nearest points to p, ordered by increasing distance.
+func (ix *Index) Nearest(p Point, k int) []Neighbor {
+	if k <= 0 || len(ix.perm) == 0 {
+		return nil
+	}
+	q := cartesian(p)
+	h := &neighborHeap{}
+	var search func(lo, hi int)
+	search = func(lo, hi int) {
+		if lo >= hi {
+			return
+		}
+		mid := (lo + hi) / 2
+		i := ix.perm[mid]
+		if d := ix.chord2(q, i); h.Len() < k {
+			heap.Push(h, Neighbor{i, d})
+		} else if d < (*h)[0].Distance {
+			(*h)[0] = Neighbor{i, d}
+			heap.Fix(h, 0)
+		}
+		diff := q[ix.axis[mid]] - ix.xyz[i][ix.axis[mid]]
+		if diff < 0 {
+			search(lo, mid)
+			if h.Len() < k || diff*diff < (*h)[0].Distance {
+				search(mid+1, hi)
+			}
+		} else {
+			search(mid+1, hi)
+			if h.Len() < k || diff*diff < (*h)[0].Distance {
+				search(lo, mid)
+			}
+		}
+	}
+	search(0, len(ix.perm))
+	return finalize(*h)
+}
+
+// Within returns the points within radius metres of p, ordered by
+// increasing distance.
+func (ix *Index) Within(p Point, radius float64) []Neighbor {
+	if radius < 0 {
+		return nil
+	}
+	q := cartesian(p)
+	limit := distanceToChord(radius)
+	var found []Neighbor
+	var search func(lo, hi int)
+	search = func(lo, hi int) {
+		if lo >= hi {
+			return
+		}
+		mid := (lo + hi) / 2
+		i := ix.perm[mid]
+		if d := ix.chord2(q, i); d <= limit {
+			found = append(found, Neighbor{i, d})
+		}
+		diff := q[ix.axis[mid]] - ix.xyz[i][ix.axis[mid]]
+		if diff <= 0 || diff*diff <= limit {
+			search(lo, mid)
+		}
+		if diff >= 0 || diff*diff <= limit {
+			search(mid+1, hi)
+		}
+	}
+	search(0, len(ix.perm))
+	return finalize(found)
+}
+
+// finalize sorts neighbors by their squared chord lengths and converts
+// them into great-circle distances.
+func finalize(neighbors []Neighbor) []Neighbor {
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Distance != neighbors[j].Distance {
+			return neighbors[i].Distance < neighbors[j].Distance
+		}
+		return neighbors[i].Index < neighbors[j].Index
+	})
+	for i := range neighbors {
+		neighbors[i].Distance = chordToDistance(neighbors[i].Distance)
+	}
+	return neighbors
+}
+
+// neighborHeap is a max-heap of neighbors ordered by distance.
+type neighborHeap []Neighbor
+
+func (h neighborHeap) Len() int            { return len(h) }
+func (h neighborHeap) Less(i, j int) bool  { return h[i].Distance > h[j].Distance }
+func (h neighborHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *neighborHeap) Push(x interface{}) { *h = append(*h, x.(Neighbor)) }
+func (h *neighborHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
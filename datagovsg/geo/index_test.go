@@ -0,0 +1,114 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomPoints returns n points spread across Singapore.
+func randomPoints(r *rand.Rand, n int) []Point {
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = Point{1.2 + r.Float64()*0.28, 103.6 + r.Float64()*0.45}
+	}
+	return points
+}
+
+// bruteForce returns all points ordered by distance to p.
+func bruteForce(points []Point, p Point) []Neighbor {
+	neighbors := make([]Neighbor, len(points))
+	for i, q := range points {
+		neighbors[i] = Neighbor{i, Distance(p, q)}
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		return neighbors[i].Distance < neighbors[j].Distance
+	})
+	return neighbors
+}
+
+// assertNeighbors compares neighbors against the expected neighbors.
+func assertNeighbors(t *testing.T, got, want []Neighbor) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v neighbors want %v", len(got), len(want))
+	}
+	for i := range got {
+		if got[i].Index != want[i].Index || math.Abs(got[i].Distance-want[i].Distance) > 1e-6 {
+			t.Errorf("got %+v want %+v at position %v", got[i], want[i], i)
+		}
+	}
+}
+
+func TestIndex_Nearest(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		n    int
+		k    int
+	}{
+		{"empty", 0, 3},
+		{"single", 1, 3},
+		{"k_zero", 100, 0},
+		{"k_one", 100, 1},
+		{"k_five", 1000, 5},
+		{"k_exceeds_points", 10, 20},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := rand.New(rand.NewSource(int64(tc.n)))
+			points := randomPoints(r, tc.n)
+			ix := NewIndex(points)
+			for _, q := range randomPoints(r, 20) {
+				want := bruteForce(points, q)
+				if tc.k < len(want) {
+					want = want[:tc.k]
+				}
+				assertNeighbors(t, ix.Nearest(q, tc.k), want)
+			}
+		})
+	}
+}
+
+func TestIndex_Within(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name   string
+		n      int
+		radius float64
+	}{
+		{"empty", 0, 1000},
+		{"negative_radius", 100, -1},
+		{"zero_radius", 100, 0},
+		{"500m", 1000, 500},
+		{"5km", 1000, 5000},
+		{"everything", 100, 100000},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := rand.New(rand.NewSource(int64(tc.n)))
+			points := randomPoints(r, tc.n)
+			ix := NewIndex(points)
+			for _, q := range randomPoints(r, 20) {
+				var want []Neighbor
+				for _, n := range bruteForce(points, q) {
+					if n.Distance <= tc.radius {
+						want = append(want, n)
+					}
+				}
+				assertNeighbors(t, ix.Within(q, tc.radius), want)
+			}
+		})
+	}
+}
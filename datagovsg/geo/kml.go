@@ -0,0 +1,193 @@
+package geo
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// KML is the root element of a KML 2.2 document.
+type KML struct {
+	XMLName  xml.Name    `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Document KMLDocument `xml:"Document"`
+}
+
+// KMLDocument is a container of folders and placemarks.
+type KMLDocument struct {
+	// Name of the document
+	Name string `xml:"name,omitempty"`
+
+	// Folders within the document
+	Folders []KMLFolder `xml:"Folder"`
+
+	// Placemarks within the document
+	Placemarks []KMLPlacemark `xml:"Placemark"`
+}
+
+// KMLFolder is a named group of placemarks.
+type KMLFolder struct {
+	// Name of the folder
+	Name string `xml:"name,omitempty"`
+
+	// Placemarks within the folder
+	Placemarks []KMLPlacemark `xml:"Placemark"`
+}
+
+// KMLPlacemark is a feature with a geometry.
+type KMLPlacemark struct {
+	// Name of the placemark
+	Name string `xml:"name,omitempty"`
+
+	// HTML description shown in the balloon of the placemark
+	Description *KMLCDATA `xml:"description,omitempty"`
+
+	// Moment in time the placemark represents
+	TimeStamp *KMLTimeStamp `xml:"TimeStamp,omitempty"`
+
+	// Period of time the placemark represents
+	TimeSpan *KMLTimeSpan `xml:"TimeSpan,omitempty"`
+
+	// Untyped data of the placemark
+	ExtendedData *KMLExtendedData `xml:"ExtendedData,omitempty"`
+
+	// Geometries of the placemark, of which only one should be set
+	Point         *KMLPoint         `xml:"Point,omitempty"`
+	LineString    *KMLLineString    `xml:"LineString,omitempty"`
+	Polygon       *KMLPolygon       `xml:"Polygon,omitempty"`
+	MultiGeometry *KMLMultiGeometry `xml:"MultiGeometry,omitempty"`
+}
+
+// KMLCDATA is text written as a CDATA section.
+type KMLCDATA struct {
+	Text string `xml:",cdata"`
+}
+
+// KMLTimeStamp represents a moment in time, given in XML Schema dateTime
+// format.
+type KMLTimeStamp struct {
+	When string `xml:"when"`
+}
+
+// KMLTimeSpan represents a period of time, given in XML Schema dateTime
+// format. An omitted begin or end leaves the period unbounded.
+type KMLTimeSpan struct {
+	Begin string `xml:"begin,omitempty"`
+	End   string `xml:"end,omitempty"`
+}
+
+// KMLExtendedData holds untyped name and value pairs.
+type KMLExtendedData struct {
+	Data []KMLData `xml:"Data"`
+}
+
+// KMLData is a name and value pair.
+type KMLData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// KMLPoint is a point geometry.
+type KMLPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// KMLLineString is a line geometry.
+type KMLLineString struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// KMLPolygon is a polygon geometry.
+type KMLPolygon struct {
+	OuterBoundaryIs KMLBoundary   `xml:"outerBoundaryIs"`
+	InnerBoundaryIs []KMLBoundary `xml:"innerBoundaryIs"`
+}
+
+// KMLBoundary is a boundary of a polygon.
+type KMLBoundary struct {
+	LinearRing KMLLinearRing `xml:"LinearRing"`
+}
+
+// KMLLinearRing is a closed line.
+type KMLLinearRing struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// KMLMultiGeometry is a collection of geometries.
+type KMLMultiGeometry struct {
+	Points   []KMLPoint   `xml:"Point"`
+	Polygons []KMLPolygon `xml:"Polygon"`
+}
+
+// NewKML returns a new KML document with the given name.
+func NewKML(name string) *KML {
+	return &KML{Document: KMLDocument{Name: name}}
+}
+
+// Encode writes the KML document to w.
+func (k *KML) Encode(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(k); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// kmlCoordinates returns the KML coordinates of the points, given as
+// longitude and latitude tuples.
+func kmlCoordinates(points ...Point) string {
+	var b strings.Builder
+	for i, p := range points {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(strconv.FormatFloat(p.Longitude, 'f', -1, 64))
+		b.WriteByte(',')
+		b.WriteString(strconv.FormatFloat(p.Latitude, 'f', -1, 64))
+	}
+	return b.String()
+}
+
+// NewKMLPoint returns a KML point geometry.
+func NewKMLPoint(p Point) *KMLPoint {
+	return &KMLPoint{Coordinates: kmlCoordinates(p)}
+}
+
+// NewKMLLineString returns a KML line geometry.
+func NewKMLLineString(points []Point) *KMLLineString {
+	return &KMLLineString{Coordinates: kmlCoordinates(points...)}
+}
+
+// NewKMLMultiPoint returns a KML collection of point geometries.
+func NewKMLMultiPoint(points []Point) *KMLMultiGeometry {
+	m := &KMLMultiGeometry{Points: make([]KMLPoint, len(points))}
+	for i, p := range points {
+		m.Points[i] = *NewKMLPoint(p)
+	}
+	return m
+}
+
+// NewKMLPolygon returns a KML polygon geometry. Rings are closed as
+// required by KML.
+func NewKMLPolygon(p Polygon) *KMLPolygon {
+	ring := func(r Ring) KMLBoundary {
+		if len(r) > 0 && r[0] != r[len(r)-1] {
+			r = append(append(Ring(nil), r...), r[0])
+		}
+		return KMLBoundary{KMLLinearRing{kmlCoordinates(r...)}}
+	}
+	k := &KMLPolygon{}
+	if len(p) == 0 {
+		return k
+	}
+	k.OuterBoundaryIs = ring(p[0])
+	for _, hole := range p[1:] {
+		k.InnerBoundaryIs = append(k.InnerBoundaryIs, ring(hole))
+	}
+	return k
+}
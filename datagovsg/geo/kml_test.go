@@ -0,0 +1,63 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestKML_Encode(t *testing.T) {
+	k := NewKML("Cameras")
+	k.Document.Placemarks = append(k.Document.Placemarks, KMLPlacemark{
+		Name:        "1701",
+		Description: &KMLCDATA{`<img src="https://example.com/1701.jpg"/>`},
+		TimeSpan:    &KMLTimeSpan{Begin: "2020-06-27T14:12:59+08:00"},
+		Point:       NewKMLPoint(Point{1.323604823, 103.8587802}),
+	})
+	k.Document.Folders = append(k.Document.Folders, KMLFolder{
+		Name: "Region",
+		Placemarks: []KMLPlacemark{{
+			Polygon: NewKMLPolygon(Polygon{{{0, 0}, {0, 1}, {1, 1}}}),
+		}},
+	})
+
+	var buf bytes.Buffer
+	if err := k.Encode(&buf); err != nil {
+		t.Fatalf("error encoding kml: %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		xml.Header,
+		`<kml xmlns="http://www.opengis.net/kml/2.2">`,
+		`<name>Cameras</name>`,
+		`<description><![CDATA[<img src="https://example.com/1701.jpg"/>]]></description>`,
+		`<TimeSpan>`,
+		`<begin>2020-06-27T14:12:59+08:00</begin>`,
+		`<coordinates>103.8587802,1.323604823</coordinates>`,
+		`<coordinates>0,0 1,0 1,1 0,0</coordinates>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %v want it to contain %v", got, want)
+		}
+	}
+	if strings.Contains(got, "<end>") || strings.Contains(got, "<TimeStamp>") {
+		t.Errorf("got %v want no empty time elements", got)
+	}
+
+	// The document should be well-formed
+	var decoded KML
+	if err := xml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("error decoding kml: %v", err)
+	}
+	if decoded.Document.Placemarks[0].Point.Coordinates != "103.8587802,1.323604823" {
+		t.Errorf("got %+v want point coordinates", decoded.Document.Placemarks[0])
+	}
+}
+
+func TestNewKMLMultiPoint(t *testing.T) {
+	m := NewKMLMultiPoint([]Point{{1.3, 103.8}, {1.4, 103.9}})
+	if len(m.Points) != 2 || m.Points[1].Coordinates != "103.9,1.4" {
+		t.Errorf("got %+v want two points", m)
+	}
+}
@@ -0,0 +1,82 @@
+package geo
+
+import "math"
+
+// LineString represents a path through a sequence of points, such as a
+// route.
+type LineString []Point
+
+// Length returns the length of the line in metres.
+func (l LineString) Length() float64 {
+	var length float64
+	for i := 1; i < len(l); i++ {
+		length += Distance(l[i-1], l[i])
+	}
+	return length
+}
+
+// Bounds returns the bounding box of the line.
+func (l LineString) Bounds() BBox {
+	b := EmptyBBox()
+	for _, p := range l {
+		b = b.Extend(p)
+	}
+	return b
+}
+
+// Project returns the point on the line nearest to p, along with the
+// distance in metres along the line to that point and the distance in
+// metres from p to that point.
+//
+// Segments are treated as straight in an equirectangular projection about
+// p, which is accurate for lines of city scale.
+func (l LineString) Project(p Point) (nearest Point, along, offset float64) {
+	if len(l) == 0 {
+		return Point{}, 0, math.Inf(1)
+	}
+	nearest, offset = l[0], Distance(p, l[0])
+	cos := math.Cos(p.Latitude * radians)
+	var travelled float64
+	for i := 1; i < len(l); i++ {
+		a, b := l[i-1], l[i]
+		length := Distance(a, b)
+
+		// Position of p along the segment in local planar coordinates
+		ax, ay := (a.Longitude-p.Longitude)*cos, a.Latitude-p.Latitude
+		bx, by := (b.Longitude-p.Longitude)*cos, b.Latitude-p.Latitude
+		dx, dy := bx-ax, by-ay
+		var t float64
+		if d2 := dx*dx + dy*dy; d2 > 0 {
+			t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/d2))
+		}
+		q := Point{
+			Latitude:  a.Latitude + t*(b.Latitude-a.Latitude),
+			Longitude: a.Longitude + t*(b.Longitude-a.Longitude),
+		}
+		if d := Distance(p, q); d < offset {
+			nearest, along, offset = q, travelled+t*length, d
+		}
+		travelled += length
+	}
+	return nearest, along, offset
+}
+
+// Buffer returns the bounding box of the line extended by the given
+// distance in metres on all sides, which contains every point within that
+// distance of the line.
+func (l LineString) Buffer(distance float64) BBox {
+	b := l.Bounds()
+	if b.Empty() {
+		return b
+	}
+	dlat := distance / metresPerDegree
+	lat := math.Max(math.Abs(b.Min.Latitude), math.Abs(b.Max.Latitude)) + dlat
+	dlon := 180.0
+	if lat < 90 {
+		dlon = math.Min(180, dlat/math.Cos(lat*radians))
+	}
+	return BBox{
+		Min: Point{Latitude: b.Min.Latitude - dlat, Longitude: b.Min.Longitude - dlon},
+		Max: Point{Latitude: b.Max.Latitude + dlat, Longitude: b.Max.Longitude + dlon},
+	}
+}
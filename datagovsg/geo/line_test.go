@@ -0,0 +1,69 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLineString_Project(t *testing.T) {
+	// Two segments of about 1112 metres each along the equator and a
+	// meridian
+	l := LineString{{0, 0}, {0, 0.01}, {0.01, 0.01}}
+
+	// Create test cases
+	cases := []struct {
+		name    string
+		p       Point
+		nearest Point
+		along   float64
+		offset  float64
+	}{
+		{"first_segment", Point{0.001, 0.005}, Point{0, 0.005}, 556, 111},
+		{"second_segment", Point{0.005, 0.012}, Point{0.005, 0.01}, 1668, 222},
+		{"vertex", Point{-0.001, 0.011}, Point{0, 0.01}, 1112, 157},
+		{"before_start", Point{0, -0.01}, Point{0, 0}, 0, 1112},
+		{"on_line", Point{0.01, 0.01}, Point{0.01, 0.01}, 2224, 0},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			nearest, along, offset := l.Project(tc.p)
+			if Distance(nearest, tc.nearest) > 1 {
+				t.Errorf("got nearest %+v want %+v", nearest, tc.nearest)
+			}
+			if math.Abs(along-tc.along) > 1 || math.Abs(offset-tc.offset) > 1 {
+				t.Errorf("got along %v offset %v want along %v offset %v", along, offset, tc.along, tc.offset)
+			}
+		})
+	}
+
+	if _, _, offset := (LineString{}).Project(Point{}); !math.IsInf(offset, 1) {
+		t.Errorf("got offset %v want %v", offset, math.Inf(1))
+	}
+}
+
+func TestLineString_Buffer(t *testing.T) {
+	l := LineString{{1.30, 103.85}, {1.35, 103.86}}
+	if got := l.Length(); math.Abs(got-5670) > 10 {
+		t.Errorf("got length %v want %v", got, 5670)
+	}
+
+	// Points just within the distance of the line are in the buffer
+	b := l.Buffer(500)
+	for _, p := range []Point{{1.30, 103.8456}, {1.3544, 103.86}, {1.32414, 103.85932}} {
+		_, _, offset := l.Project(p)
+		if offset > 500 || !b.Contains(p) {
+			t.Errorf("got offset %v contained %v for %+v", offset, b.Contains(p), p)
+		}
+	}
+	if b.Contains(Point{1.30, 103.84}) {
+		t.Errorf("got point outside buffer contained")
+	}
+	if !(LineString{}).Buffer(500).Empty() {
+		t.Errorf("got non-empty buffer of empty line")
+	}
+}
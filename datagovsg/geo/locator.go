@@ -0,0 +1,86 @@
+package geo
+
+import "math"
+
+const (
+	// The default size in degrees of the cells of a Locator grid,
+	// approximately 1.1km at the equator.
+	locatorCellSize = 0.01
+)
+
+// Locator finds the named shapes containing a point.
+//
+// Shapes are bucketed into a uniform grid by their bounding boxes, so a
+// query only tests the few shapes whose bounding boxes overlap its cell.
+type Locator struct {
+	names  []string
+	shapes []MultiPolygon
+	bounds []BBox
+	extent BBox
+	grid   map[[2]int][]int
+}
+
+// NewLocator returns a new empty Locator.
+func NewLocator() *Locator {
+	return &Locator{
+		extent: EmptyBBox(),
+		grid:   make(map[[2]int][]int),
+	}
+}
+
+// cell returns the grid cell containing a coordinate.
+func (l *Locator) cell(lat, lon float64) [2]int {
+	return [2]int{int(math.Floor(lat / locatorCellSize)), int(math.Floor(lon / locatorCellSize))}
+}
+
+// Add adds a named shape to the locator.
+func (l *Locator) Add(name string, shape MultiPolygon) {
+	i := len(l.shapes)
+	b := shape.Bounds()
+	l.names = append(l.names, name)
+	l.shapes = append(l.shapes, shape)
+	l.bounds = append(l.bounds, b)
+	if b.Empty() {
+		return
+	}
+	l.extent = l.extent.Union(b)
+	min, max := l.cell(b.Min.Latitude, b.Min.Longitude), l.cell(b.Max.Latitude, b.Max.Longitude)
+	for y := min[0]; y <= max[0]; y++ {
+		for x := min[1]; x <= max[1]; x++ {
+			l.grid[[2]int{y, x}] = append(l.grid[[2]int{y, x}], i)
+		}
+	}
+}
+
+// Len returns the number of shapes in the locator.
+func (l *Locator) Len() int {
+	return len(l.shapes)
+}
+
+// Names returns the names of the shapes in the order they were added.
+func (l *Locator) Names() []string {
+	return append([]string(nil), l.names...)
+}
+
+// Shape returns the first shape with the given name.
+func (l *Locator) Shape(name string) (MultiPolygon, bool) {
+	for i, n := range l.names {
+		if n == name {
+			return l.shapes[i], true
+		}
+	}
+	return nil, false
+}
+
+// Locate returns the name of the first shape containing the point.
+func (l *Locator) Locate(p Point) (string, bool) {
+	if !l.extent.Contains(p) {
+		return "", false
+	}
+	for _, i := range l.grid[l.cell(p.Latitude, p.Longitude)] {
+		if l.bounds[i].Contains(p) && l.shapes[i].Contains(p) {
+			return l.names[i], true
+		}
+	}
+	return "", false
+}
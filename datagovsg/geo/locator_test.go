@@ -0,0 +1,68 @@
+package geo
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLocator_Locate(t *testing.T) {
+	l := NewLocator()
+	l.Add("outer", MultiPolygon{{
+		{{1.30, 103.80}, {1.30, 103.90}, {1.40, 103.90}, {1.40, 103.80}},
+		{{1.34, 103.84}, {1.34, 103.86}, {1.36, 103.86}, {1.36, 103.84}},
+	}})
+	l.Add("inner", MultiPolygon{{
+		{{1.34, 103.84}, {1.34, 103.86}, {1.36, 103.86}, {1.36, 103.84}},
+	}})
+	l.Add("islands", MultiPolygon{
+		{{{1.20, 103.80}, {1.20, 103.82}, {1.22, 103.82}, {1.22, 103.80}}},
+		{{{1.20, 104.00}, {1.20, 104.02}, {1.22, 104.02}, {1.22, 104.00}}},
+	})
+
+	// Create test cases
+	cases := []struct {
+		name string
+		p    Point
+		want string
+		ok   bool
+	}{
+		{"outer", Point{1.32, 103.82}, "outer", true},
+		{"hole", Point{1.35, 103.85}, "inner", true},
+		{"first_island", Point{1.21, 103.81}, "islands", true},
+		{"second_island", Point{1.21, 104.01}, "islands", true},
+		{"between_islands", Point{1.21, 103.90}, "", false},
+		{"outside", Point{1.50, 103.85}, "", false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := l.Locate(tc.p)
+			if got != tc.want || ok != tc.ok {
+				t.Errorf("got (%v, %v) want (%v, %v)", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func BenchmarkLocator_Locate(b *testing.B) {
+	// A 30x30 grid of adjoining squares covering Singapore
+	l := NewLocator()
+	const n, size = 30, 0.015
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			lat, lon := 1.15+float64(i)*size, 103.6+float64(j)*size
+			l.Add("", MultiPolygon{{{
+				{lat, lon}, {lat, lon + size}, {lat + size, lon + size}, {lat + size, lon},
+			}}})
+		}
+	}
+	points := randomPoints(rand.New(rand.NewSource(1)), 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Locate(points[i%len(points)])
+	}
+}
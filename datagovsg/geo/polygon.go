@@ -0,0 +1,152 @@
+package geo
+
+import "math"
+
+// Ring represents a closed ring of points. The last point does not need
+// to repeat the first point.
+type Ring []Point
+
+// Contains returns true if the point lies within the ring, using the
+// even-odd rule.
+func (r Ring) Contains(p Point) bool {
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a.Latitude > p.Latitude) != (b.Latitude > p.Latitude) {
+			lon := a.Longitude + (p.Latitude-a.Latitude)*(b.Longitude-a.Longitude)/(b.Latitude-a.Latitude)
+			if p.Longitude < lon {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// Bounds returns the bounding box of the ring.
+func (r Ring) Bounds() BBox {
+	b := EmptyBBox()
+	for _, p := range r {
+		b = b.Extend(p)
+	}
+	return b
+}
+
+// Clip returns the part of the ring within a convex clip ring, using the
+// Sutherland-Hodgman algorithm in the longitude and latitude plane. If the
+// ring is concave and the result falls into several parts, they are joined
+// by edges of zero width along the clip ring. The result is empty if the
+// ring lies entirely outside the clip ring.
+func (r Ring) Clip(clip Ring) Ring {
+	poly := make([]vec2, len(r))
+	for i, p := range r {
+		poly[i] = vec2{p.Longitude, p.Latitude}
+	}
+
+	// Keep the side of each edge facing the interior of the clip ring
+	sign := 1.0
+	if signedArea(clip) < 0 {
+		sign = -1
+	}
+	for i := range clip {
+		a, b := clip[i], clip[(i+1)%len(clip)]
+		n := vec2{sign * (b.Latitude - a.Latitude), sign * (a.Longitude - b.Longitude)}
+		poly = clipHalfPlane(poly, n, n[0]*a.Longitude+n[1]*a.Latitude)
+	}
+	if len(poly) == 0 {
+		return nil
+	}
+	out := make(Ring, len(poly))
+	for i, v := range poly {
+		out[i] = Point{Latitude: v[1], Longitude: v[0]}
+	}
+	return out
+}
+
+// Polygon represents a polygon with an outer ring followed by zero or more
+// holes.
+type Polygon []Ring
+
+// Contains returns true if the point lies within the outer ring and
+// outside all holes of the polygon.
+func (p Polygon) Contains(q Point) bool {
+	if len(p) == 0 || !p[0].Contains(q) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.Contains(q) {
+			return false
+		}
+	}
+	return true
+}
+
+// Bounds returns the bounding box of the polygon.
+func (p Polygon) Bounds() BBox {
+	if len(p) == 0 {
+		return EmptyBBox()
+	}
+	return p[0].Bounds()
+}
+
+// MultiPolygon represents a collection of disjoint polygons.
+type MultiPolygon []Polygon
+
+// Contains returns true if the point lies within any of the polygons.
+func (m MultiPolygon) Contains(q Point) bool {
+	for _, p := range m {
+		if p.Contains(q) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bounds returns the bounding box of the polygons.
+func (m MultiPolygon) Bounds() BBox {
+	b := EmptyBBox()
+	for _, p := range m {
+		b = b.Union(p.Bounds())
+	}
+	return b
+}
+
+// BBox represents a bounding box.
+type BBox struct {
+	Min Point
+	Max Point
+}
+
+// EmptyBBox returns a bounding box containing no points.
+func EmptyBBox() BBox {
+	return BBox{
+		Min: Point{math.Inf(1), math.Inf(1)},
+		Max: Point{math.Inf(-1), math.Inf(-1)},
+	}
+}
+
+// Empty returns true if the bounding box contains no points.
+func (b BBox) Empty() bool {
+	return b.Min.Latitude > b.Max.Latitude || b.Min.Longitude > b.Max.Longitude
+}
+
+// Contains returns true if the point lies within the bounding box.
+func (b BBox) Contains(p Point) bool {
+	return p.Latitude >= b.Min.Latitude && p.Latitude <= b.Max.Latitude &&
+		p.Longitude >= b.Min.Longitude && p.Longitude <= b.Max.Longitude
+}
+
+// Extend returns the bounding box extended to contain the point.
+func (b BBox) Extend(p Point) BBox {
+	return BBox{
+		Min: Point{math.Min(b.Min.Latitude, p.Latitude), math.Min(b.Min.Longitude, p.Longitude)},
+		Max: Point{math.Max(b.Max.Latitude, p.Latitude), math.Max(b.Max.Longitude, p.Longitude)},
+	}
+}
+
+// Union returns the smallest bounding box containing both bounding boxes.
+func (b BBox) Union(o BBox) BBox {
+	if o.Empty() {
+		return b
+	}
+	return b.Extend(o.Min).Extend(o.Max)
+}
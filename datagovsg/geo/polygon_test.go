@@ -0,0 +1,158 @@
+package geo
+
+import "testing"
+
+func TestRing_Contains(t *testing.T) {
+	// A concave ring shaped like the letter U
+	u := Ring{
+		{0, 0}, {0, 3}, {3, 3}, {3, 2}, {1, 2}, {1, 1}, {3, 1}, {3, 0},
+	}
+
+	// Create test cases
+	cases := []struct {
+		name string
+		ring Ring
+		p    Point
+		want bool
+	}{
+		{"inside", u, Point{0.5, 1.5}, true},
+		{"inside_arm", u, Point{2.5, 0.5}, true},
+		{"concavity", u, Point{2, 1.5}, false},
+		{"outside", u, Point{4, 1}, false},
+		{"closed_ring", append(u, u[0]), Point{0.5, 1.5}, true},
+		{"empty", Ring{}, Point{0, 0}, false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.ring.Contains(tc.p); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRing_Clip(t *testing.T) {
+	// A concave ring shaped like the letter U
+	u := Ring{
+		{0, 0}, {0, 3}, {3, 3}, {3, 2}, {1, 2}, {1, 1}, {3, 1}, {3, 0},
+	}
+	west := Ring{{-1, -1}, {4, -1}, {4, 2}, {-1, 2}}
+
+	// Create test cases
+	cases := []struct {
+		name    string
+		clip    Ring
+		inside  []Point
+		outside []Point
+	}{
+		{"west", west, []Point{{0.5, 0.5}, {0.5, 1.5}, {2.5, 0.5}}, []Point{{2.5, 2.5}, {2, 1.5}}},
+		{"reversed", Ring{west[3], west[2], west[1], west[0]}, []Point{{0.5, 0.5}, {2.5, 0.5}}, []Point{{2.5, 2.5}}},
+		// The arms are separate parts of the result
+		{"arms", Ring{{2, -1}, {4, -1}, {4, 4}, {2, 4}}, []Point{{2.5, 0.5}, {2.5, 2.5}}, []Point{{2.5, 1.5}, {0.5, 0.5}}},
+		{"containing", Ring{{-1, -1}, {4, -1}, {4, 4}, {-1, 4}}, []Point{{0.5, 0.5}, {2.5, 2.5}}, []Point{{2, 1.5}}},
+		{"disjoint", Ring{{5, 5}, {6, 5}, {6, 6}, {5, 6}}, nil, []Point{{0.5, 0.5}, {5.5, 5.5}}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := u.Clip(tc.clip)
+			if len(tc.inside) == 0 && len(got) > 0 {
+				t.Errorf("got %+v want empty ring", got)
+			}
+			for _, p := range tc.inside {
+				if !got.Contains(p) {
+					t.Errorf("expected %+v to be inside %+v", p, got)
+				}
+			}
+			for _, p := range tc.outside {
+				if got.Contains(p) {
+					t.Errorf("expected %+v to be outside %+v", p, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	// A square with a square hole in the middle
+	p := Polygon{
+		{{0, 0}, {0, 3}, {3, 3}, {3, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}},
+	}
+
+	// Create test cases
+	cases := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"inside", Point{0.5, 0.5}, true},
+		{"hole", Point{1.5, 1.5}, false},
+		{"outside", Point{4, 4}, false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := p.Contains(tc.p); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMultiPolygon_Contains(t *testing.T) {
+	m := MultiPolygon{
+		{{{0, 0}, {0, 1}, {1, 1}, {1, 0}}},
+		{{{2, 2}, {2, 3}, {3, 3}, {3, 2}}},
+	}
+
+	// Create test cases
+	cases := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"first", Point{0.5, 0.5}, true},
+		{"second", Point{2.5, 2.5}, true},
+		{"between", Point{1.5, 1.5}, false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := m.Contains(tc.p); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMultiPolygon_Bounds(t *testing.T) {
+	m := MultiPolygon{
+		{{{0, 0}, {0, 1}, {1, 1}, {1, 0}}},
+		{{{2, 2}, {2, 3}, {3, 3}, {3, 2}}},
+	}
+	want := BBox{Min: Point{0, 0}, Max: Point{3, 3}}
+	if got := m.Bounds(); got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if !(MultiPolygon{}).Bounds().Empty() {
+		t.Errorf("got non-empty bounds for empty multipolygon")
+	}
+}
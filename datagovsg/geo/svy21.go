@@ -0,0 +1,115 @@
+package geo
+
+import "math"
+
+// Parameters of the SVY21 projection, a Transverse Mercator projection on
+// the WGS84 ellipsoid as defined by the Singapore Land Authority.
+const (
+	svy21A         = 6378137.0                // semi-major axis in metres
+	svy21F         = 1 / 298.257223563        // flattening
+	svy21OriginLat = 1.366666                 // origin latitude in degrees
+	svy21OriginLon = 103.833333               // origin longitude in degrees
+	svy21FalseN    = 38744.572                // false northing in metres
+	svy21FalseE    = 28001.642                // false easting in metres
+	svy21K         = 1.0                      // central meridian scale factor
+	svy21E2        = 2*svy21F - svy21F*svy21F // eccentricity squared
+)
+
+// svy21MeridionalArc returns the meridional arc length in metres at
+// latitude lat (in radians).
+func svy21MeridionalArc(lat float64) float64 {
+	e2, e4, e6 := svy21E2, svy21E2*svy21E2, svy21E2*svy21E2*svy21E2
+	a0 := 1 - e2/4 - 3*e4/64 - 5*e6/256
+	a2 := 3.0 / 8 * (e2 + e4/4 + 15*e6/128)
+	a4 := 15.0 / 256 * (e4 + 3*e6/4)
+	a6 := 35 * e6 / 3072
+	return svy21A * (a0*lat - a2*math.Sin(2*lat) + a4*math.Sin(4*lat) - a6*math.Sin(6*lat))
+}
+
+// SVY21ToWGS84 converts SVY21 easting and northing (in metres) into WGS84
+// latitude and longitude (in degrees).
+func SVY21ToWGS84(easting, northing float64) (latitude, longitude float64) {
+	a, e2 := svy21A, svy21E2
+	b := a * (1 - svy21F)
+
+	// Footpoint latitude
+	n := (a - b) / (a + b)
+	n2, n3, n4 := n*n, n*n*n, n*n*n*n
+	g := a * (1 - n) * (1 - n2) * (1 + 9*n2/4 + 225*n4/64) * radians
+	m := svy21MeridionalArc(svy21OriginLat*radians) + (northing-svy21FalseN)/svy21K
+	sigma := m * math.Pi / (180 * g)
+	latP := sigma + (3*n/2-27*n3/32)*math.Sin(2*sigma) +
+		(21*n2/16-55*n4/32)*math.Sin(4*sigma) +
+		(151*n3/96)*math.Sin(6*sigma) +
+		(1097*n4/512)*math.Sin(8*sigma)
+
+	// Radii of curvature at the footpoint latitude
+	sinLatP := math.Sin(latP)
+	rho := a * (1 - e2) / math.Pow(1-e2*sinLatP*sinLatP, 1.5)
+	v := a / math.Sqrt(1-e2*sinLatP*sinLatP)
+	psi := v / rho
+	t := math.Tan(latP)
+	de := easting - svy21FalseE
+	x := de / (svy21K * v)
+
+	psi2, psi3, psi4 := psi*psi, psi*psi*psi, psi*psi*psi*psi
+	t2, t4, t6 := t*t, t*t*t*t, t*t*t*t*t*t
+	x3, x5, x7 := x*x*x, x*x*x*x*x, x*x*x*x*x*x*x
+
+	// Latitude
+	latFactor := t / (svy21K * rho)
+	lat1 := latFactor * de * x / 2
+	lat2 := latFactor * de * x3 / 24 * (-4*psi2 + 9*psi*(1-t2) + 12*t2)
+	lat3 := latFactor * de * x5 / 720 *
+		(8*psi4*(11-24*t2) - 12*psi3*(21-71*t2) + 15*psi2*(15-98*t2+15*t4) + 180*psi*(5*t2-3*t4) + 360*t4)
+	lat4 := latFactor * de * x7 / 40320 * (1385 - 3633*t2 + 4095*t4 + 1575*t6)
+	lat := latP - lat1 + lat2 - lat3 + lat4
+
+	// Longitude
+	secLatP := 1 / math.Cos(latP)
+	lon1 := x * secLatP
+	lon2 := x3 * secLatP / 6 * (psi + 2*t2)
+	lon3 := x5 * secLatP / 120 * (-4*psi3*(1-6*t2) + psi2*(9-68*t2) + 72*psi*t2 + 24*t4)
+	lon4 := x7 * secLatP / 5040 * (61 + 662*t2 + 1320*t4 + 720*t6)
+	lon := svy21OriginLon*radians + lon1 - lon2 + lon3 - lon4
+
+	return lat / radians, lon / radians
+}
+
+// WGS84ToSVY21 converts WGS84 latitude and longitude (in degrees) into
+// SVY21 easting and northing (in metres).
+func WGS84ToSVY21(latitude, longitude float64) (easting, northing float64) {
+	a, e2 := svy21A, svy21E2
+	lat := latitude * radians
+	w := (longitude - svy21OriginLon) * radians
+
+	// Radii of curvature at the latitude
+	sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+	rho := a * (1 - e2) / math.Pow(1-e2*sinLat*sinLat, 1.5)
+	v := a / math.Sqrt(1-e2*sinLat*sinLat)
+	psi := v / rho
+	t := math.Tan(lat)
+
+	psi2, psi3, psi4 := psi*psi, psi*psi*psi, psi*psi*psi*psi
+	t2, t4, t6 := t*t, t*t*t*t, t*t*t*t*t*t
+	w2, w4, w6, w8 := w*w, w*w*w*w, math.Pow(w, 6), math.Pow(w, 8)
+	cos2, cos3, cos4 := cosLat*cosLat, math.Pow(cosLat, 3), math.Pow(cosLat, 4)
+	cos5, cos6, cos7 := math.Pow(cosLat, 5), math.Pow(cosLat, 6), math.Pow(cosLat, 7)
+
+	// Northing
+	n1 := w2 / 2 * v * sinLat * cosLat
+	n2 := w4 / 24 * v * sinLat * cos3 * (4*psi2 + psi - t2)
+	n3 := w6 / 720 * v * sinLat * cos5 *
+		(8*psi4*(11-24*t2) - 28*psi3*(1-6*t2) + psi2*(1-32*t2) - psi*2*t2 + t4)
+	n4 := w8 / 40320 * v * sinLat * cos7 * (1385 - 3111*t2 + 543*t4 - t6)
+	m := svy21MeridionalArc(lat) - svy21MeridionalArc(svy21OriginLat*radians)
+	northing = svy21FalseN + svy21K*(m+n1+n2+n3+n4)
+
+	// Easting
+	et1 := w2 / 6 * cos2 * (psi - t2)
+	et2 := w4 / 120 * cos4 * (4*psi3*(1-6*t2) + psi2*(1+8*t2) - psi*2*t2 + t4)
+	et3 := w6 / 5040 * cos6 * (61 - 479*t2 + 179*t4 - t6)
+	easting = svy21FalseE + svy21K*v*w*cosLat*(1+et1+et2+et3)
+
+	return easting, northing
+}
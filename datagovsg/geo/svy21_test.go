@@ -0,0 +1,97 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+// Reference points for the SVY21 projection, spread across the island
+// from Tuas to Pedra Branca. The projection origin is defined by the
+// Singapore Land Authority and the OneMap point is a published conversion
+// from the OneMap coordinate converter. The remaining points were
+// converted with the Kruger series for the Transverse Mercator projection
+// (Karney, 2011), which is independent of the series used by the
+// conversions, and are rounded to 0.1mm.
+var svy21ReferencePoints = []struct {
+	name      string
+	easting   float64
+	northing  float64
+	latitude  float64
+	longitude float64
+
+	// Tolerances in metres and degrees
+	metres  float64
+	degrees float64
+}{
+	{"origin", 28001.642, 38744.572, 1.366666, 103.833333, 1e-3, 1e-8},
+	{"onemap", 28983.788791079794, 33554.5098132845, 1.319728905, 103.8421581, 1e-3, 1e-8},
+	{"tuas", 6040.1624, 30820.9677, 1.2950, 103.6360, 1e-3, 1e-8},
+	{"jurong_island", 12606.1958, 27503.2784, 1.2650, 103.6950, 1e-3, 1e-8},
+	{"woodlands", 23068.0588, 46654.4877, 1.4382, 103.7890, 1e-3, 1e-8},
+	{"sembawang", 26350.9645, 47859.7125, 1.4491, 103.8185, 1e-3, 1e-8},
+	{"sentosa", 27664.0897, 25777.9013, 1.2494, 103.8303, 1e-3, 1e-8},
+	{"changi", 45603.7758, 38494.5878, 1.3644, 103.9915, 1e-3, 1e-8},
+	{"pulau_tekong", 52113.7057, 43537.3478, 1.4100, 104.0500, 1e-3, 1e-8},
+	{"pedra_branca", 91656.7367, 34719.7240, 1.3302, 104.4053, 1e-3, 1e-8},
+}
+
+func TestSVY21ToWGS84(t *testing.T) {
+	// Run test cases
+	for _, tc := range svy21ReferencePoints {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Assert within tolerance, where 1e-8 degrees is approximately 1mm
+			lat, lon := SVY21ToWGS84(tc.easting, tc.northing)
+			if math.Abs(lat-tc.latitude) > tc.degrees || math.Abs(lon-tc.longitude) > tc.degrees {
+				t.Errorf("got (%v, %v) want (%v, %v)", lat, lon, tc.latitude, tc.longitude)
+			}
+		})
+	}
+}
+
+func TestWGS84ToSVY21(t *testing.T) {
+	// Run test cases
+	for _, tc := range svy21ReferencePoints {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Assert within tolerance
+			e, n := WGS84ToSVY21(tc.latitude, tc.longitude)
+			if math.Abs(e-tc.easting) > tc.metres || math.Abs(n-tc.northing) > tc.metres {
+				t.Errorf("got (%v, %v) want (%v, %v)", e, n, tc.easting, tc.northing)
+			}
+		})
+	}
+}
+
+func TestSVY21_RoundTrip(t *testing.T) {
+	// Create test cases spanning the extent of Singapore
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+	}{
+		{"tuas", 1.2950, 103.6360},
+		{"changi", 1.3644, 103.9915},
+		{"woodlands", 1.4382, 103.7890},
+		{"sentosa", 1.2494, 103.8303},
+		{"pedra_branca", 1.3302, 104.4053},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, n := WGS84ToSVY21(tc.latitude, tc.longitude)
+			lat, lon := SVY21ToWGS84(e, n)
+			if math.Abs(lat-tc.latitude) > 1e-9 || math.Abs(lon-tc.longitude) > 1e-9 {
+				t.Errorf("got (%v, %v) want (%v, %v)", lat, lon, tc.latitude, tc.longitude)
+			}
+		})
+	}
+}
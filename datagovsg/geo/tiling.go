@@ -0,0 +1,182 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Tiling partitions the surface of the Earth into cells identified by
+// string keys.
+type Tiling interface {
+	// Key returns the key of the cell containing the point.
+	Key(p Point) string
+
+	// Boundary returns the boundary of the cell with the given key.
+	Boundary(key string) (Ring, bool)
+}
+
+// The alphabet used by geohashes.
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeohash returns the geohash of the point with the given number of
+// characters.
+func EncodeGeohash(p Point, precision int) string {
+	lat, lon := [2]float64{-90, 90}, [2]float64{-180, 180}
+	var b strings.Builder
+	var ch, bit int
+	even := true
+	for b.Len() < precision {
+		if even {
+			ch = ch<<1 | bisect(&lon, p.Longitude)
+		} else {
+			ch = ch<<1 | bisect(&lat, p.Latitude)
+		}
+		even = !even
+		if bit++; bit == 5 {
+			b.WriteByte(geohashAlphabet[ch])
+			ch, bit = 0, 0
+		}
+	}
+	return b.String()
+}
+
+// bisect halves the interval towards v and returns 1 if v lies in the
+// upper half.
+func bisect(interval *[2]float64, v float64) int {
+	mid := (interval[0] + interval[1]) / 2
+	if v >= mid {
+		interval[0] = mid
+		return 1
+	}
+	interval[1] = mid
+	return 0
+}
+
+// DecodeGeohash returns the bounding box of the geohash.
+func DecodeGeohash(hash string) (BBox, bool) {
+	lat, lon := [2]float64{-90, 90}, [2]float64{-180, 180}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := strings.IndexByte(geohashAlphabet, hash[i])
+		if ch < 0 {
+			return BBox{}, false
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			interval := &lat
+			if even {
+				interval = &lon
+			}
+			mid := (interval[0] + interval[1]) / 2
+			if ch&mask != 0 {
+				interval[0] = mid
+			} else {
+				interval[1] = mid
+			}
+			even = !even
+		}
+	}
+	return BBox{Min: Point{lat[0], lon[0]}, Max: Point{lat[1], lon[1]}}, len(hash) > 0
+}
+
+// The default number of characters of the geohashes of a GeohashTiling.
+const geohashTilingPrecision = 6
+
+// GeohashTiling partitions the Earth into geohash cells.
+type GeohashTiling struct {
+	// Number of characters of the geohashes, e.g. 6 for cells of
+	// approximately 1.2km by 0.6km, or 6 if it is not positive
+	Precision int
+}
+
+// precision returns the number of characters of the geohashes, using the
+// default for invalid precisions.
+func (t GeohashTiling) precision() int {
+	if t.Precision <= 0 {
+		return geohashTilingPrecision
+	}
+	return t.Precision
+}
+
+// Key returns the geohash of the point.
+func (t GeohashTiling) Key(p Point) string {
+	return EncodeGeohash(p, t.precision())
+}
+
+// Boundary returns the boundary of the geohash cell.
+func (t GeohashTiling) Boundary(key string) (Ring, bool) {
+	b, ok := DecodeGeohash(key)
+	if !ok {
+		return nil, false
+	}
+	return Ring{
+		{b.Min.Latitude, b.Min.Longitude},
+		{b.Min.Latitude, b.Max.Longitude},
+		{b.Max.Latitude, b.Max.Longitude},
+		{b.Max.Latitude, b.Min.Longitude},
+	}, true
+}
+
+// The default size in metres of the hexagons of a HexTiling.
+const hexTilingSize = 500
+
+// HexTiling partitions the Earth into pointy-top hexagons.
+//
+// Hexagons are regular in an equirectangular projection about the equator,
+// so they are stretched north to south by the inverse cosine of the
+// latitude, which is negligible near Singapore.
+type HexTiling struct {
+	// Distance in metres from the centre of a hexagon to its vertices, or
+	// 500 if it is not a positive finite number
+	Size float64
+}
+
+// size returns the size of the hexagons, using the default for invalid
+// sizes.
+func (t HexTiling) size() float64 {
+	if !(t.Size > 0) || math.IsInf(t.Size, 1) {
+		return hexTilingSize
+	}
+	return t.Size
+}
+
+// Key returns the axial coordinates of the hexagon containing the point,
+// formatted as "q,r".
+func (t HexTiling) Key(p Point) string {
+	size := t.size()
+	x, y := p.Longitude*metresPerDegree, p.Latitude*metresPerDegree
+	q := (math.Sqrt(3)/3*x - y/3) / size
+	r := (2.0 / 3 * y) / size
+
+	// Round the fractional cube coordinates to the nearest hexagon
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	}
+	return fmt.Sprintf("%d,%d", int(rq), int(rr))
+}
+
+// Boundary returns the vertices of the hexagon with the given axial
+// coordinates.
+func (t HexTiling) Boundary(key string) (Ring, bool) {
+	var q, r int
+	if n, err := fmt.Sscanf(key, "%d,%d", &q, &r); n != 2 || err != nil {
+		return nil, false
+	}
+	size := t.size()
+	x := size * (math.Sqrt(3)*float64(q) + math.Sqrt(3)/2*float64(r))
+	y := size * (1.5 * float64(r))
+	ring := make(Ring, 6)
+	for i := range ring {
+		angle := (60*float64(i) - 30) * radians
+		ring[i] = Point{
+			Latitude:  (y + size*math.Sin(angle)) / metresPerDegree,
+			Longitude: (x + size*math.Cos(angle)) / metresPerDegree,
+		}
+	}
+	return ring, true
+}
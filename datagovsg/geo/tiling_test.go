@@ -0,0 +1,140 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		p         Point
+		precision int
+		want      string
+	}{
+		{"wikipedia", Point{42.6, -5.6}, 5, "ezs42"},
+		{"jutland", Point{57.64911, 10.40744}, 11, "u4pruydqqvj"},
+		{"empty", Point{1.35, 103.8}, 0, ""},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := EncodeGeohash(tc.p, tc.precision); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeGeohash(t *testing.T) {
+	b, ok := DecodeGeohash("ezs42")
+	if !ok {
+		t.Fatalf("got invalid geohash")
+	}
+	if !b.Contains(Point{42.6, -5.6}) {
+		t.Errorf("got %+v want bounds containing %+v", b, Point{42.6, -5.6})
+	}
+	for _, hash := range []string{"", "ezs4a"} {
+		if _, ok := DecodeGeohash(hash); ok {
+			t.Errorf("got valid geohash for %q", hash)
+		}
+	}
+}
+
+func TestTiling(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name   string
+		tiling Tiling
+	}{
+		{"geohash", GeohashTiling{Precision: 6}},
+		{"geohash_default_precision", GeohashTiling{}},
+		{"geohash_negative_precision", GeohashTiling{Precision: -1}},
+		{"hex", HexTiling{Size: 500}},
+		{"hex_default_size", HexTiling{}},
+		{"hex_negative_size", HexTiling{Size: -1}},
+		{"hex_nan_size", HexTiling{Size: math.NaN()}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Every point should lie within the boundary of its cell
+			for _, p := range randomPoints(rand.New(rand.NewSource(1)), 1000) {
+				key := tc.tiling.Key(p)
+				ring, ok := tc.tiling.Boundary(key)
+				if !ok {
+					t.Fatalf("got invalid key %q", key)
+				}
+				if !ring.Contains(p) {
+					t.Errorf("got %+v outside boundary of %q", p, key)
+				}
+			}
+			if _, ok := tc.tiling.Boundary("invalid"); ok {
+				t.Errorf("got valid boundary for invalid key")
+			}
+		})
+	}
+}
+
+func TestGeohashTiling_Key(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		precision int
+		want      string
+	}{
+		{"precision", 5, "ezs42"},
+		{"default_precision", 0, "ezs42e"},
+		{"negative_precision", -1, "ezs42e"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tiling := GeohashTiling{Precision: tc.precision}
+			if got := tiling.Key(Point{42.6, -5.6}); got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHexTiling_Boundary(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		size float64
+		want float64
+	}{
+		{"size", 250, 250},
+		{"default_size", 0, 500},
+		{"infinite_size", math.Inf(1), 500},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ring, _ := HexTiling{Size: tc.size}.Boundary("10,-4")
+			for i := range ring {
+				if d := Distance(ring[i], ring[(i+1)%len(ring)]); math.Abs(d-tc.want) > 1 {
+					t.Errorf("got side length %v want %v", d, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package geo
+
+import "math"
+
+// VoronoiCells returns the Voronoi cell of each site clipped to the
+// boundary, such that every point within the boundary belongs to the cell
+// of its nearest site.
+//
+// Cells are computed in a local equirectangular projection, which is
+// accurate for areas the size of Singapore. A cell may be empty if its
+// site is shadowed by another site at the same location or if the cell
+// lies entirely outside the boundary.
+func VoronoiCells(sites []Point, boundary Ring) []Ring {
+	var lat0 float64
+	for _, p := range boundary {
+		lat0 += p.Latitude
+	}
+	if len(boundary) > 0 {
+		lat0 /= float64(len(boundary))
+	}
+	return voronoiCells(sites, boundary, lat0)
+}
+
+// VoronoiMultiPolygonCells returns the Voronoi cell of each site clipped
+// to a boundary made up of several polygons, such as a coastline with
+// islands. Each cell holds the parts of the polygons nearest to its site,
+// and is empty if there are none.
+func VoronoiMultiPolygonCells(sites []Point, boundary MultiPolygon) []MultiPolygon {
+	b := boundary.Bounds()
+	lat0 := (b.Min.Latitude + b.Max.Latitude) / 2
+	cells := make([]MultiPolygon, len(sites))
+	for _, polygon := range boundary {
+		// Clip every ring of the polygon, so that holes are clipped along
+		// with the outer ring
+		rings := make([][]Ring, len(polygon))
+		for i, r := range polygon {
+			rings[i] = voronoiCells(sites, r, lat0)
+		}
+		for i := range sites {
+			if len(polygon) == 0 || len(rings[0][i]) == 0 {
+				continue
+			}
+			var cell Polygon
+			for _, r := range rings {
+				if len(r[i]) > 0 {
+					cell = append(cell, r[i])
+				}
+			}
+			cells[i] = append(cells[i], cell)
+		}
+	}
+	return cells
+}
+
+// voronoiCells returns the Voronoi cell of each site clipped to the
+// boundary in an equirectangular projection about the given latitude.
+func voronoiCells(sites []Point, boundary Ring, lat0 float64) []Ring {
+	// Project to a local plane with equal scales along both axes
+	k := math.Cos(lat0 * radians)
+	project := func(p Point) vec2 { return vec2{p.Longitude * k, p.Latitude} }
+
+	b := make([]vec2, len(boundary))
+	for i, p := range boundary {
+		b[i] = project(p)
+	}
+	s := make([]vec2, len(sites))
+	for i, p := range sites {
+		s[i] = project(p)
+	}
+
+	// Clip the boundary by the bisector between each pair of sites
+	cells := make([]Ring, len(sites))
+	for i := range sites {
+		cell := b
+		for j := range sites {
+			if i == j || len(cell) == 0 {
+				continue
+			}
+			n := vec2{s[j][0] - s[i][0], s[j][1] - s[i][1]}
+			if n[0] == 0 && n[1] == 0 {
+				// Keep the first of any coincident sites
+				if j < i {
+					cell = nil
+				}
+				continue
+			}
+			m := vec2{(s[i][0] + s[j][0]) / 2, (s[i][1] + s[j][1]) / 2}
+			cell = clipHalfPlane(cell, n, n[0]*m[0]+n[1]*m[1])
+		}
+		ring := make(Ring, len(cell))
+		for i, v := range cell {
+			ring[i] = Point{Latitude: v[1], Longitude: v[0] / k}
+		}
+		cells[i] = ring
+	}
+	return cells
+}
+
+// vec2 represents a point on a plane.
+type vec2 [2]float64
+
+// clipHalfPlane clips a polygon to the half-plane of points p satisfying
+// n·p <= c using the Sutherland-Hodgman algorithm.
+func clipHalfPlane(poly []vec2, n vec2, c float64) []vec2 {
+	if len(poly) == 0 {
+		return nil
+	}
+	eval := func(p vec2) float64 { return n[0]*p[0] + n[1]*p[1] - c }
+	var out []vec2
+	prev := poly[len(poly)-1]
+	prevD := eval(prev)
+	for _, cur := range poly {
+		curD := eval(cur)
+		if (curD <= 0) != (prevD <= 0) {
+			t := prevD / (prevD - curD)
+			out = append(out, vec2{prev[0] + t*(cur[0]-prev[0]), prev[1] + t*(cur[1]-prev[1])})
+		}
+		if curD <= 0 {
+			out = append(out, cur)
+		}
+		prev, prevD = cur, curD
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// area returns the area of a ring in square degrees.
+func area(r Ring) float64 {
+	var a float64
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a += r[j].Longitude*r[i].Latitude - r[i].Longitude*r[j].Latitude
+	}
+	return math.Abs(a / 2)
+}
+
+func TestVoronoiCells(t *testing.T) {
+	boundary := Ring{{1.2, 103.6}, {1.2, 104.0}, {1.5, 104.0}, {1.5, 103.6}}
+	r := rand.New(rand.NewSource(1))
+	sites := randomPoints(r, 30)
+	sites = append(sites, sites[0]) // coincident site
+	cells := VoronoiCells(sites, boundary)
+	if len(cells) != len(sites) {
+		t.Fatalf("got %v cells want %v", len(cells), len(sites))
+	}
+
+	// Assert cells partition the boundary
+	var total float64
+	for _, c := range cells {
+		total += area(c)
+	}
+	if want := area(boundary); math.Abs(total-want) > 1e-9 {
+		t.Errorf("got total area %v want %v", total, want)
+	}
+	if len(cells[len(cells)-1]) != 0 {
+		t.Errorf("expected empty cell for coincident site but got: %v", cells[len(cells)-1])
+	}
+
+	// Assert points belong to the cell of their nearest site
+	ix := NewIndex(sites)
+	for _, p := range randomPoints(r, 200) {
+		if !boundary.Contains(p) {
+			continue
+		}
+		nearest := ix.Nearest(p, 2)
+		if nearest[1].Distance-nearest[0].Distance < 10 {
+			continue // too close to a cell edge
+		}
+		if !cells[nearest[0].Index].Contains(p) {
+			t.Errorf("expected %v in the cell of site %v", p, nearest[0].Index)
+		}
+	}
+}
+
+func TestVoronoiMultiPolygonCells(t *testing.T) {
+	// An island with a lake, and a smaller island to the east
+	boundary := MultiPolygon{
+		{
+			{{1.2, 103.6}, {1.2, 103.9}, {1.5, 103.9}, {1.5, 103.6}},
+			{{1.3, 103.7}, {1.3, 103.8}, {1.4, 103.8}, {1.4, 103.7}},
+		},
+		{
+			{{1.3, 103.95}, {1.3, 104.05}, {1.4, 104.05}, {1.4, 103.95}},
+		},
+	}
+	r := rand.New(rand.NewSource(1))
+	sites := randomPoints(r, 30)
+	cells := VoronoiMultiPolygonCells(sites, boundary)
+	if len(cells) != len(sites) {
+		t.Fatalf("got %v cells want %v", len(cells), len(sites))
+	}
+
+	// Assert cells partition the boundary
+	polygonArea := func(p Polygon) float64 {
+		a := area(p[0])
+		for _, hole := range p[1:] {
+			a -= area(hole)
+		}
+		return a
+	}
+	var total, want float64
+	for _, c := range cells {
+		for _, p := range c {
+			total += polygonArea(p)
+		}
+	}
+	for _, p := range boundary {
+		want += polygonArea(p)
+	}
+	if math.Abs(total-want) > 1e-9 {
+		t.Errorf("got total area %v want %v", total, want)
+	}
+
+	// Assert points belong to the cell of their nearest site
+	ix := NewIndex(sites)
+	for _, p := range randomPoints(r, 200) {
+		if !boundary.Contains(p) {
+			continue
+		}
+		nearest := ix.Nearest(p, 2)
+		if nearest[1].Distance-nearest[0].Distance < 10 {
+			continue // too close to a cell edge
+		}
+		if !cells[nearest[0].Index].Contains(p) {
+			t.Errorf("expected %v in the cell of site %v", p, nearest[0].Index)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package datagovsg
+
+import (
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// ToGeoJSON returns the traffic cameras of the latest item as GeoJSON
+// point features, with the image details as properties.
+func (t *TrafficImages) ToGeoJSON() *geo.FeatureCollection {
+	fc := geo.NewFeatureCollection()
+	if len(t.Items) == 0 {
+		return fc
+	}
+	for _, c := range t.Items[len(t.Items)-1].Cameras {
+		fc.Add(geo.NewFeature(c.CameraID, geo.NewPointGeometry(geo.Point{Latitude: c.Location.Latitude, Longitude: c.Location.Longitude}), map[string]interface{}{
+			"camera_id":    c.CameraID,
+			"timestamp":    c.Timestamp,
+			"image":        c.Image,
+			"image_height": c.ImageMetadata.Height,
+			"image_width":  c.ImageMetadata.Width,
+			"image_md5":    c.ImageMetadata.MD5,
+		}))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the available taxis as GeoJSON MultiPoint features.
+// Unlike the API response, the output has no crs member, as RFC 7946
+// mandates WGS84 coordinates.
+func (t *TaxiAvailability) ToGeoJSON() *geo.FeatureCollection {
+	fc := geo.NewFeatureCollection()
+	for i := range t.Features {
+		f := &t.Features[i]
+		fc.Add(geo.NewFeature("", geo.NewMultiPointGeometry(f.Points()), map[string]interface{}{
+			"timestamp":  f.Properties.Timestamp,
+			"taxi_count": f.Properties.TaxiCount,
+		}))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings of the latest item as properties.
+func (a *AirTemperature) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(a, MeasurementAirTemperature, nil)
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings of the latest item as properties.
+func (r *Rainfall) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(r, MeasurementRainfall, nil)
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings of the latest item as properties.
+func (r *RelativeHumidity) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(r, MeasurementRelativeHumidity, nil)
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings of the latest item as properties.
+func (w *WindDirection) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(w, MeasurementWindDirection, nil)
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings of the latest item as properties.
+func (w *WindSpeed) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(w, MeasurementWindSpeed, nil)
+}
+
+// ToGeoJSON returns the weather stations as GeoJSON point features, with
+// the readings and heat stress categories of the latest record as
+// properties.
+func (w *WBGT) ToGeoJSON() *geo.FeatureCollection {
+	return stationFeatures(w, MeasurementWBGT, w.heatStressProperties())
+}
+
+// heatStressProperties returns the heat stress categories of the latest
+// record as GeoJSON properties keyed by station ID.
+func (w *WBGT) heatStressProperties() map[string]map[string]interface{} {
+	props := make(map[string]map[string]interface{})
+	if n := len(w.Data.Records); n > 0 {
+		for _, r := range w.Data.Records[n-1].Item.Readings {
+			props[r.Station.ID] = map[string]interface{}{"heat_stress": string(r.HeatStress)}
+		}
+	}
+	return props
+}
+
+// stationFeatures returns the stations of a resource as GeoJSON point
+// features, with their latest readings of a measurement and any extra
+// properties keyed by station ID as properties.
+func stationFeatures(r StationReadingLister, m Measurement, extra map[string]map[string]interface{}) *geo.FeatureCollection {
+	readings := make(map[string]StationReading)
+	for _, reading := range latestReadings(r.StationReadings()) {
+		readings[reading.StationID] = reading
+	}
+	fc := geo.NewFeatureCollection()
+	for _, s := range r.Stations() {
+		props := map[string]interface{}{
+			"id":          s.ID,
+			"device_id":   s.DeviceID,
+			"name":        s.Name,
+			"measurement": string(m),
+		}
+		if reading, ok := readings[s.ID]; ok {
+			props["value"] = reading.Value
+			props["timestamp"] = reading.Timestamp
+			if reading.Unit != "" {
+				props["reading_unit"] = reading.Unit
+			}
+		}
+		for k, v := range extra[s.ID] {
+			props[k] = v
+		}
+		fc.Add(geo.NewFeature(s.ID, geo.NewPointGeometry(geo.Point{Latitude: s.Latitude, Longitude: s.Longitude}), props))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the label locations of the forecast areas as GeoJSON
+// point features, with the forecasts of the latest item as properties.
+func (f *TwoHourWeatherForecast) ToGeoJSON() *geo.FeatureCollection {
+	forecasts := f.latestForecasts()
+	fc := geo.NewFeatureCollection()
+	for _, a := range f.AreaMetadata {
+		p := geo.Point{Latitude: a.LabelLocation.Latitude, Longitude: a.LabelLocation.Longitude}
+		fc.Add(geo.NewFeature(a.Name, geo.NewPointGeometry(p), forecastProperties(a.Name, forecasts)))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the cells of the forecast areas as GeoJSON multipolygon
+// features, with the forecasts of the latest item as properties.
+func (r *ForecastAreaResolver) ToGeoJSON() *geo.FeatureCollection {
+	forecasts := r.forecast.latestForecasts()
+	fc := geo.NewFeatureCollection()
+	for i, area := range r.areas {
+		if len(r.cells[i]) == 0 {
+			continue
+		}
+		fc.Add(geo.NewFeature(area, geo.NewMultiPolygonGeometry(r.cells[i]), forecastProperties(area, forecasts)))
+	}
+	return fc
+}
+
+// latestForecasts returns the forecasts of the latest item keyed by area,
+// along with the item.
+func (f *TwoHourWeatherForecast) latestForecasts() map[string]forecastItem {
+	forecasts := make(map[string]forecastItem)
+	if len(f.Items) == 0 {
+		return forecasts
+	}
+	item := f.Items[len(f.Items)-1]
+	for _, fc := range item.Forecasts {
+		forecasts[fc.Area] = forecastItem{item, fc.Forecast}
+	}
+	return forecasts
+}
+
+// forecastItem represents the forecast of an area within an item.
+type forecastItem struct {
+	item     TwoHourWeatherForecastItem
+	forecast string
+}
+
+// forecastProperties returns the GeoJSON properties of a forecast area.
+func forecastProperties(area string, forecasts map[string]forecastItem) map[string]interface{} {
+	props := map[string]interface{}{"name": area}
+	if f, ok := forecasts[area]; ok {
+		props["forecast"] = f.forecast
+		props["timestamp"] = f.item.Timestamp
+		props["valid_period_start"] = f.item.ValidPeriod.Start
+		props["valid_period_end"] = f.item.ValidPeriod.End
+	}
+	return props
+}
+
+// ToGeoJSON returns the regions as GeoJSON features, with the readings of
+// the latest item as properties. Regions are given as multipolygons where a
+// boundary is known and as their label locations otherwise. The national
+// region is excluded as it has no location.
+func (p *PSI) ToGeoJSON() *geo.FeatureCollection {
+	fc := geo.NewFeatureCollection()
+	for _, r := range p.RegionMetadata {
+		if r.Name == RegionNational {
+			continue
+		}
+		props := map[string]interface{}{"name": r.Name}
+		if n := len(p.Items); n > 0 {
+			item := p.Items[n-1]
+			readings := item.Readings.Region(r.Name)
+			props["timestamp"] = item.Timestamp
+			props["psi_twenty_four_hourly"] = readings.PSITwentyFourHourly
+			props["pm10_sub_index"] = readings.PM10SubIndex
+			props["pm10_twenty_four_hourly"] = readings.PM10TwentyFourHourly
+			props["pm25_sub_index"] = readings.PM25SubIndex
+			props["pm25_twenty_four_hourly"] = readings.PM25TwentyFourHourly
+			props["o3_sub_index"] = readings.O3SubIndex
+			props["o3_eight_hour_max"] = readings.O3EightHourMax
+			props["co_sub_index"] = readings.COSubIndex
+			props["co_eight_hour_max"] = readings.COEightHourMax
+			props["so2_sub_index"] = readings.SO2SubIndex
+			props["so2_twenty_four_hourly"] = readings.SO2TwentyFourHourly
+			props["no2_one_hour_max"] = readings.NO2OneHourMax
+		}
+		label := geo.Point{Latitude: r.LabelLocation.Latitude, Longitude: r.LabelLocation.Longitude}
+		fc.Add(geo.NewFeature(r.Name, regionGeometry(r.Name, label), props))
+	}
+	return fc
+}
+
+// ToGeoJSON returns the regions as GeoJSON features, with the readings of
+// the latest item as properties. Regions are given as multipolygons where a
+// boundary is known and as their label locations otherwise. The national
+// region is excluded as it has no location.
+func (p *PM25) ToGeoJSON() *geo.FeatureCollection {
+	fc := geo.NewFeatureCollection()
+	for _, r := range p.RegionMetadata {
+		if r.Name == RegionNational {
+			continue
+		}
+		props := map[string]interface{}{"name": r.Name}
+		if n := len(p.Items); n > 0 {
+			item := p.Items[n-1]
+			props["timestamp"] = item.Timestamp
+			props["pm25_one_hourly"] = item.Readings.PM25OneHourly[r.Name]
+		}
+		label := geo.Point{Latitude: r.LabelLocation.Latitude, Longitude: r.LabelLocation.Longitude}
+		fc.Add(geo.NewFeature(r.Name, regionGeometry(r.Name, label), props))
+	}
+	return fc
+}
+
+// regionGeometry returns the boundary of a region as a multipolygon, or its
+// label location if the boundary is not known.
+func regionGeometry(region string, label geo.Point) *geo.Geometry {
+	if boundary, ok := RegionBoundary(region); ok {
+		return geo.NewMultiPolygonGeometry(boundary)
+	}
+	return geo.NewPointGeometry(label)
+}
@@ -0,0 +1,196 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// assertFeature checks the geometry type and properties of a feature.
+func assertFeature(t *testing.T, f geo.Feature, geometry string, props map[string]interface{}) {
+	t.Helper()
+	if f.Type != "Feature" || f.Geometry == nil || f.Geometry.Type != geometry {
+		t.Errorf("got feature %+v want %v geometry", f, geometry)
+	}
+	for k, want := range props {
+		if got := f.Properties[k]; got != want {
+			t.Errorf("got %v=%v want %v", k, got, want)
+		}
+	}
+	if _, err := json.Marshal(f); err != nil {
+		t.Errorf("error marshalling feature: %v", err)
+	}
+}
+
+func TestTrafficImages_ToGeoJSON(t *testing.T) {
+	var images TrafficImages
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", &images)
+	fc := images.ToGeoJSON()
+	if got, want := len(fc.Features), len(images.Items[0].Cameras); got != want {
+		t.Fatalf("got %v features want %v", got, want)
+	}
+	assertFeature(t, fc.Features[0], geo.GeometryPoint, map[string]interface{}{
+		"camera_id":    "1701",
+		"image_height": 480,
+		"image_md5":    "b6f50862dff928bdc34778488c0bb360",
+	})
+	if got, _ := fc.Features[0].Geometry.Point(); got != (geo.Point{Latitude: 1.323604823, Longitude: 103.8587802}) {
+		t.Errorf("got %+v want camera location", got)
+	}
+}
+
+func TestTaxiAvailability_ToGeoJSON(t *testing.T) {
+	var taxis TaxiAvailability
+	loadFixture(t, "testdata/fixtures/transport_taxiavailability_default.json", &taxis)
+	fc := taxis.ToGeoJSON()
+	if len(fc.Features) != 1 {
+		t.Fatalf("got %v features want %v", len(fc.Features), 1)
+	}
+	assertFeature(t, fc.Features[0], geo.GeometryMultiPoint, map[string]interface{}{
+		"timestamp":  "2019-12-31T23:59:15+08:00",
+		"taxi_count": 5093,
+	})
+	b, _ := json.Marshal(fc)
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatalf("error unmarshalling feature collection: %v", err)
+	}
+	if _, ok := raw["crs"]; ok {
+		t.Errorf("got crs member want none")
+	}
+}
+
+func TestStationResources_ToGeoJSON(t *testing.T) {
+	var airTemperature AirTemperature
+	loadFixture(t, "testdata/fixtures/environment_airtemperature_default.json", &airTemperature)
+	var rainfall Rainfall
+	loadFixture(t, "testdata/fixtures/environment_rainfall_default.json", &rainfall)
+	var relativeHumidity RelativeHumidity
+	loadFixture(t, "testdata/fixtures/environment_relativehumidity_default.json", &relativeHumidity)
+	var windDirection WindDirection
+	loadFixture(t, "testdata/fixtures/environment_winddirection_default.json", &windDirection)
+	var windSpeed WindSpeed
+	loadFixture(t, "testdata/fixtures/environment_windspeed_default.json", &windSpeed)
+	var wbgt WBGT
+	loadFixture(t, "testdata/fixtures/environment_wbgt_default.json", &wbgt)
+
+	// Create test cases
+	cases := []struct {
+		name        string
+		fc          *geo.FeatureCollection
+		stations    int
+		measurement Measurement
+	}{
+		{"air_temperature", airTemperature.ToGeoJSON(), len(airTemperature.Stations()), MeasurementAirTemperature},
+		{"rainfall", rainfall.ToGeoJSON(), len(rainfall.Stations()), MeasurementRainfall},
+		{"relative_humidity", relativeHumidity.ToGeoJSON(), len(relativeHumidity.Stations()), MeasurementRelativeHumidity},
+		{"wind_direction", windDirection.ToGeoJSON(), len(windDirection.Stations()), MeasurementWindDirection},
+		{"wind_speed", windSpeed.ToGeoJSON(), len(windSpeed.Stations()), MeasurementWindSpeed},
+		{"wbgt", wbgt.ToGeoJSON(), len(wbgt.Stations()), MeasurementWBGT},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if len(tc.fc.Features) != tc.stations || tc.stations == 0 {
+				t.Fatalf("got %v features want %v", len(tc.fc.Features), tc.stations)
+			}
+			var readings int
+			for _, f := range tc.fc.Features {
+				assertFeature(t, f, geo.GeometryPoint, map[string]interface{}{
+					"id":          string(f.ID),
+					"measurement": string(tc.measurement),
+				})
+				if _, ok := f.Properties["value"]; ok {
+					if _, ok := f.Properties["timestamp"]; !ok {
+						t.Errorf("got reading without timestamp for %v", f.ID)
+					}
+					readings++
+				}
+			}
+			if readings == 0 {
+				t.Errorf("got no features with readings")
+			}
+		})
+	}
+}
+
+func TestWBGT_ToGeoJSON(t *testing.T) {
+	var wbgt WBGT
+	loadFixture(t, "testdata/fixtures/environment_wbgt_default.json", &wbgt)
+	latest := wbgt.Data.Records[len(wbgt.Data.Records)-1].Item.Readings[0]
+	for _, f := range wbgt.ToGeoJSON().Features {
+		if string(f.ID) != latest.Station.ID {
+			continue
+		}
+		assertFeature(t, f, geo.GeometryPoint, map[string]interface{}{
+			"value":       latest.WBGT,
+			"heat_stress": string(latest.HeatStress),
+		})
+		return
+	}
+	t.Errorf("got no feature for station %v", latest.Station.ID)
+}
+
+func TestTwoHourWeatherForecast_ToGeoJSON(t *testing.T) {
+	var forecast TwoHourWeatherForecast
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", &forecast)
+	item := forecast.Items[len(forecast.Items)-1]
+	want := map[string]interface{}{
+		"name":      item.Forecasts[0].Area,
+		"forecast":  item.Forecasts[0].Forecast,
+		"timestamp": item.Timestamp,
+	}
+
+	points := forecast.ToGeoJSON()
+	if got, want := len(points.Features), len(forecast.AreaMetadata); got != want {
+		t.Fatalf("got %v features want %v", got, want)
+	}
+	assertFeature(t, points.Features[0], geo.GeometryPoint, want)
+
+	// Every area has a cell
+	cells := NewForecastAreaResolver(&forecast).ToGeoJSON()
+	if got, want := len(cells.Features), len(forecast.AreaMetadata); got != want {
+		t.Fatalf("got %v features want %v", got, want)
+	}
+	assertFeature(t, cells.Features[0], geo.GeometryMultiPolygon, want)
+}
+
+func TestRegionResources_ToGeoJSON(t *testing.T) {
+	var psi PSI
+	loadFixture(t, "testdata/fixtures/environment_psi_default.json", &psi)
+	var pm25 PM25
+	loadFixture(t, "testdata/fixtures/environment_pm25_default.json", &pm25)
+
+	// Create test cases
+	cases := []struct {
+		name  string
+		fc    *geo.FeatureCollection
+		props map[string]interface{}
+	}{
+		{"psi", psi.ToGeoJSON(), map[string]interface{}{"name": RegionWest, "o3_sub_index": 11}},
+		{"pm25", pm25.ToGeoJSON(), map[string]interface{}{"name": RegionWest, "pm25_one_hourly": 14}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if len(tc.fc.Features) != 5 {
+				t.Fatalf("got %v features want %v", len(tc.fc.Features), 5)
+			}
+			assertFeature(t, tc.fc.Features[0], geo.GeometryMultiPolygon, tc.props)
+			for _, f := range tc.fc.Features {
+				if f.ID == geo.FeatureID(RegionNational) {
+					t.Errorf("got national region want none")
+				}
+			}
+		})
+	}
+}
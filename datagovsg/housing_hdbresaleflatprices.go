@@ -0,0 +1,124 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// HDBResaleFlatPrices is the resource representing the resale prices of
+// HDB flats.
+type HDBResaleFlatPrices struct {
+	Help    string                    `json:"help"`
+	Success bool                      `json:"success"`
+	Result  HDBResaleFlatPricesResult `json:"result"`
+}
+
+// HDBResaleFlatPricesResult represents a page of HDB resale flat price
+// records.
+type HDBResaleFlatPricesResult struct {
+	// ID of the resource
+	ResourceID string `json:"resource_id"`
+
+	// Fields of the records
+	Fields []DatastoreSearchField `json:"fields"`
+
+	// HDB resale flat price records
+	Records []HDBResaleFlatPricesRecord `json:"records"`
+
+	// Links to the current and next pages
+	Links DatastoreSearchLinks `json:"_links"`
+
+	// Maximum number of records returned
+	Limit int `json:"limit"`
+
+	// Total number of records matching the search
+	Total int `json:"total"`
+}
+
+// HDBResaleFlatPricesRecord represents a single HDB resale transaction.
+type HDBResaleFlatPricesRecord struct {
+	// Month of registration of the transaction
+	Month time.Time
+
+	// Town of the flat
+	Town string
+
+	// Type of the flat, e.g. "4 ROOM"
+	FlatType string
+
+	// Block number of the flat
+	Block string
+
+	// Street name of the flat
+	StreetName string
+
+	// Range of storeys of the flat, e.g. "10 TO 12"
+	StoreyRange string
+
+	// Floor area in square metres
+	FloorArea float64
+
+	// Model of the flat, e.g. "Improved"
+	FlatModel string
+
+	// Year of commencement of the lease
+	LeaseCommenceDate int
+
+	// Remaining lease in months
+	RemainingLease int
+
+	// Resale price in dollars
+	ResalePrice float64
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *HDBResaleFlatPricesRecord) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Month             string `json:"month"`
+		Town              string `json:"town"`
+		FlatType          string `json:"flat_type"`
+		Block             string `json:"block"`
+		StreetName        string `json:"street_name"`
+		StoreyRange       string `json:"storey_range"`
+		FloorArea         string `json:"floor_area_sqm"`
+		FlatModel         string `json:"flat_model"`
+		LeaseCommenceDate string `json:"lease_commence_date"`
+		RemainingLease    string `json:"remaining_lease"`
+		ResalePrice       string `json:"resale_price"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	p := &datastoreValueParser{}
+	*r = HDBResaleFlatPricesRecord{
+		Month:             p.Month("month", raw.Month),
+		Town:              raw.Town,
+		FlatType:          raw.FlatType,
+		Block:             raw.Block,
+		StreetName:        raw.StreetName,
+		StoreyRange:       raw.StoreyRange,
+		FloorArea:         p.Float("floor_area_sqm", raw.FloorArea),
+		FlatModel:         raw.FlatModel,
+		LeaseCommenceDate: p.Int("lease_commence_date", raw.LeaseCommenceDate),
+		RemainingLease:    p.Months("remaining_lease", raw.RemainingLease),
+		ResalePrice:       p.Float("resale_price", raw.ResalePrice),
+	}
+	return p.err
+}
+
+// GetHDBResaleFlatPrices returns the resale prices of HDB flats.
+func (c *Client) GetHDBResaleFlatPrices(options ...*QueryOption) (*HDBResaleFlatPrices, error) {
+	// Execute request
+	b, err := c.getDatastoreSearch(ResourceIDHDBResaleFlatPrices, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &HDBResaleFlatPrices{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,107 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_GetHDBResaleFlatPrices(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/housing_hdbresaleflatprices_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.DatastoreBaseURL = server.URL
+			got, err := client.GetHDBResaleFlatPrices()
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &HDBResaleFlatPrices{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestHDBResaleFlatPricesRecord_UnmarshalJSON(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		data string
+		want HDBResaleFlatPricesRecord
+		err  bool
+	}{
+		{
+			"default",
+			`{"month":"2017-01","town":"ANG MO KIO","floor_area_sqm":"44","lease_commence_date":"1979","remaining_lease":"61 years 04 months","resale_price":"232000"}`,
+			HDBResaleFlatPricesRecord{Month: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), Town: "ANG MO KIO", FloorArea: 44, LeaseCommenceDate: 1979, RemainingLease: 736, ResalePrice: 232000},
+			false,
+		},
+		{
+			"invalid_price",
+			`{"month":"2017-01","resale_price":"$232,000"}`,
+			HDBResaleFlatPricesRecord{Month: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+			true,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got HDBResaleFlatPricesRecord
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if (err != nil) != tc.err {
+				t.Errorf("expected error %v but got: %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
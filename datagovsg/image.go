@@ -0,0 +1,229 @@
+package datagovsg
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+)
+
+const (
+	// The dimensions of a glyph of the label font in pixels.
+	glyphWidth  = 5
+	glyphHeight = 7
+
+	// The spacing between glyphs and around labels in pixels.
+	glyphSpacing = 1
+	labelPadding = 2
+
+	// The quality of encoded JPEG images.
+	imageJPEGQuality = 90
+)
+
+var (
+	// ErrImageFormat is returned by EncodeImage calls when the image format
+	// is not supported.
+	ErrImageFormat = errors.New("datagovsg: unsupported image format")
+)
+
+// labelFont is a 5x7 bitmap font for labelling images. Each glyph is
+// given as rows of bits, with the most significant of the five bits
+// being the leftmost pixel.
+var labelFont = map[rune][glyphHeight]uint8{
+	'0': {0x0E, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0E},
+	'1': {0x04, 0x0C, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	'2': {0x0E, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1F},
+	'3': {0x1F, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0E},
+	'4': {0x02, 0x06, 0x0A, 0x12, 0x1F, 0x02, 0x02},
+	'5': {0x1F, 0x10, 0x1E, 0x01, 0x01, 0x11, 0x0E},
+	'6': {0x06, 0x08, 0x10, 0x1E, 0x11, 0x11, 0x0E},
+	'7': {0x1F, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	'8': {0x0E, 0x11, 0x11, 0x0E, 0x11, 0x11, 0x0E},
+	'9': {0x0E, 0x11, 0x11, 0x0F, 0x01, 0x02, 0x0C},
+	'A': {0x0E, 0x11, 0x11, 0x1F, 0x11, 0x11, 0x11},
+	'B': {0x1E, 0x11, 0x11, 0x1E, 0x11, 0x11, 0x1E},
+	'C': {0x0E, 0x11, 0x10, 0x10, 0x10, 0x11, 0x0E},
+	'D': {0x1C, 0x12, 0x11, 0x11, 0x11, 0x12, 0x1C},
+	'E': {0x1F, 0x10, 0x10, 0x1E, 0x10, 0x10, 0x1F},
+	'F': {0x1F, 0x10, 0x10, 0x1E, 0x10, 0x10, 0x10},
+	'G': {0x0E, 0x11, 0x10, 0x17, 0x11, 0x11, 0x0F},
+	'H': {0x11, 0x11, 0x11, 0x1F, 0x11, 0x11, 0x11},
+	'I': {0x0E, 0x04, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	'J': {0x07, 0x02, 0x02, 0x02, 0x02, 0x12, 0x0C},
+	'K': {0x11, 0x12, 0x14, 0x18, 0x14, 0x12, 0x11},
+	'L': {0x10, 0x10, 0x10, 0x10, 0x10, 0x10, 0x1F},
+	'M': {0x11, 0x1B, 0x15, 0x15, 0x11, 0x11, 0x11},
+	'N': {0x11, 0x11, 0x19, 0x15, 0x13, 0x11, 0x11},
+	'O': {0x0E, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0E},
+	'P': {0x1E, 0x11, 0x11, 0x1E, 0x10, 0x10, 0x10},
+	'Q': {0x0E, 0x11, 0x11, 0x11, 0x15, 0x12, 0x0D},
+	'R': {0x1E, 0x11, 0x11, 0x1E, 0x14, 0x12, 0x11},
+	'S': {0x0F, 0x10, 0x10, 0x0E, 0x01, 0x01, 0x1E},
+	'T': {0x1F, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04},
+	'U': {0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x0E},
+	'V': {0x11, 0x11, 0x11, 0x11, 0x11, 0x0A, 0x04},
+	'W': {0x11, 0x11, 0x11, 0x15, 0x15, 0x15, 0x0A},
+	'X': {0x11, 0x11, 0x0A, 0x04, 0x0A, 0x11, 0x11},
+	'Y': {0x11, 0x11, 0x11, 0x0A, 0x04, 0x04, 0x04},
+	'Z': {0x1F, 0x01, 0x02, 0x04, 0x08, 0x10, 0x1F},
+	' ': {},
+	':': {0x00, 0x0C, 0x0C, 0x00, 0x0C, 0x0C, 0x00},
+	'-': {0x00, 0x00, 0x00, 0x1F, 0x00, 0x00, 0x00},
+	'+': {0x00, 0x04, 0x04, 0x1F, 0x04, 0x04, 0x00},
+	'.': {0x00, 0x00, 0x00, 0x00, 0x00, 0x0C, 0x0C},
+	'/': {0x00, 0x01, 0x02, 0x04, 0x08, 0x10, 0x00},
+	'(': {0x02, 0x04, 0x08, 0x08, 0x08, 0x04, 0x02},
+	')': {0x08, 0x04, 0x02, 0x02, 0x02, 0x04, 0x08},
+	'?': {0x0E, 0x11, 0x01, 0x02, 0x04, 0x00, 0x04},
+}
+
+// labelSize returns the size in pixels of a label of the text drawn by
+// drawLabel at the given scale.
+func labelSize(text string, scale int) image.Point {
+	n := len([]rune(text))
+	w := n*(glyphWidth+glyphSpacing) - glyphSpacing
+	if n == 0 {
+		w = 0
+	}
+	return image.Pt((w+2*labelPadding)*scale, (glyphHeight+2*labelPadding)*scale)
+}
+
+// drawLabel draws the text in white on a black box with its top left
+// corner at pt, with each pixel of the font enlarged by the given scale.
+// Lower case letters are drawn in upper case and characters missing from
+// the font as question marks.
+func drawLabel(dst draw.Image, pt image.Point, text string, scale int) {
+	if scale < 1 {
+		scale = 1
+	}
+	box := image.Rectangle{Min: pt, Max: pt.Add(labelSize(text, scale))}
+	draw.Draw(dst, box, image.Black, image.Point{}, draw.Src)
+
+	x := pt.X + labelPadding*scale
+	y := pt.Y + labelPadding*scale
+	for _, r := range strings.ToUpper(text) {
+		glyph, ok := labelFont[r]
+		if !ok {
+			glyph = labelFont['?']
+		}
+		for row, bits := range glyph {
+			for col := 0; col < glyphWidth; col++ {
+				if bits&(1<<(glyphWidth-1-col)) == 0 {
+					continue
+				}
+				px := image.Rect(x+col*scale, y+row*scale, x+(col+1)*scale, y+(row+1)*scale)
+				draw.Draw(dst, px, image.White, image.Point{}, draw.Src)
+			}
+		}
+		x += (glyphWidth + glyphSpacing) * scale
+	}
+}
+
+// scaleImage returns the image scaled to the given size, averaging the
+// source pixels covered by each destination pixel.
+func scaleImage(src image.Image, width, height int) *image.RGBA {
+	// Convert to RGBA for direct access to the pixels
+	b := src.Bounds()
+	rgba, ok := src.(*image.RGBA)
+	if !ok {
+		rgba = image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), src, b.Min, draw.Src)
+	}
+	sb := rgba.Bounds()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if sb.Empty() {
+		return dst
+	}
+	for y := 0; y < height; y++ {
+		y0 := sb.Min.Y + y*sb.Dy()/height
+		y1 := sb.Min.Y + (y+1)*sb.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := sb.Min.X + x*sb.Dx()/width
+			x1 := sb.Min.X + (x+1)*sb.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				i := rgba.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint32(rgba.Pix[i])
+					g += uint32(rgba.Pix[i+1])
+					bl += uint32(rgba.Pix[i+2])
+					a += uint32(rgba.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{uint8(r / n), uint8(g / n), uint8(bl / n), uint8(a / n)})
+		}
+	}
+	return dst
+}
+
+// scaleToWidth returns the image scaled to the given width, preserving its
+// aspect ratio. The image is returned as is if width is not positive.
+func scaleToWidth(src image.Image, width int) image.Image {
+	b := src.Bounds()
+	if width <= 0 || b.Dx() == 0 || b.Dx() == width {
+		return src
+	}
+	height := b.Dy() * width / b.Dx()
+	if height < 1 {
+		height = 1
+	}
+	return scaleImage(src, width, height)
+}
+
+// scaleToFit returns the image scaled to fit within the given size,
+// preserving its aspect ratio.
+func scaleToFit(src image.Image, width, height int) *image.RGBA {
+	b := src.Bounds()
+	w, h := width, height
+	if b.Dx() > 0 && b.Dy() > 0 {
+		if b.Dx()*height > b.Dy()*width {
+			h = b.Dy() * width / b.Dx()
+		} else {
+			w = b.Dx() * height / b.Dy()
+		}
+	}
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	return scaleImage(src, w, h)
+}
+
+// ImageFormat represents an encoding of images.
+type ImageFormat string
+
+const (
+	// ImageFormatJPEG represents JPEG images.
+	ImageFormatJPEG ImageFormat = "jpeg"
+
+	// ImageFormatPNG represents PNG images.
+	ImageFormatPNG ImageFormat = "png"
+)
+
+// EncodeImage writes the image to w in the given format.
+func EncodeImage(w io.Writer, img image.Image, format ImageFormat) error {
+	switch format {
+	case ImageFormatJPEG:
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: imageJPEGQuality})
+	case ImageFormatPNG:
+		return png.Encode(w, img)
+	default:
+		return fmt.Errorf("%w: %v", ErrImageFormat, format)
+	}
+}
@@ -0,0 +1,93 @@
+package datagovsg
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestLabelFont(t *testing.T) {
+	for r, glyph := range labelFont {
+		for row, bits := range glyph {
+			if bits >= 1<<glyphWidth {
+				t.Errorf("glyph %q row %d: got %#x wider than %d pixels", r, row, bits, glyphWidth)
+			}
+		}
+	}
+}
+
+func TestDrawLabel(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		text  string
+		scale int
+		size  image.Point
+	}{
+		{"1001", 1, image.Pt(27, 11)},
+		{"pie (tuas)", 2, image.Pt(126, 22)},
+		{"", 1, image.Pt(4, 11)},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.text, func(t *testing.T) {
+			t.Parallel()
+			if got := labelSize(tc.text, tc.scale); got != tc.size {
+				t.Fatalf("got %+v want %+v", got, tc.size)
+			}
+
+			// Pixels are only drawn within the label
+			img := image.NewGray(image.Rect(0, 0, 200, 40))
+			for i := range img.Pix {
+				img.Pix[i] = 128
+			}
+			drawLabel(img, image.Pt(3, 4), tc.text, tc.scale)
+			box := image.Rectangle{Min: image.Pt(3, 4), Max: image.Pt(3, 4).Add(tc.size)}
+			var white int
+			for y := 0; y < 40; y++ {
+				for x := 0; x < 200; x++ {
+					v := img.GrayAt(x, y).Y
+					inside := image.Pt(x, y).In(box)
+					if !inside && v != 128 || inside && v != 0 && v != 255 {
+						t.Fatalf("got pixel %d at (%d, %d) inside %v", v, x, y, inside)
+					}
+					if v == 255 {
+						white++
+					}
+				}
+			}
+			if (white > 0) != (tc.text != "") {
+				t.Errorf("got %d white pixels for text %q", white, tc.text)
+			}
+		})
+	}
+}
+
+func TestScaleImage(t *testing.T) {
+	// Left half black and right half white
+	src := image.NewGray(image.Rect(0, 0, 40, 20))
+	for y := 0; y < 20; y++ {
+		for x := 20; x < 40; x++ {
+			src.SetGray(x, y, color.Gray{255})
+		}
+	}
+
+	got := scaleToWidth(src, 4)
+	if got.Bounds() != image.Rect(0, 0, 4, 2) {
+		t.Fatalf("got bounds %v want %v", got.Bounds(), image.Rect(0, 0, 4, 2))
+	}
+	for x, want := range []uint32{0, 0, 0xffff, 0xffff} {
+		if r, _, _, _ := got.At(x, 1).RGBA(); r != want {
+			t.Errorf("got %#x at x=%d want %#x", r, x, want)
+		}
+	}
+
+	// Averages pixels when the boundary falls within a destination pixel
+	if r, _, _, _ := scaleImage(src, 1, 1).At(0, 0).RGBA(); r != 0x7f7f {
+		t.Errorf("got %#x want %#x", r, 0x7f7f)
+	}
+	if got := scaleToWidth(src, 0); got != image.Image(src) {
+		t.Errorf("got scaled image want source")
+	}
+}
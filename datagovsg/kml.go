@@ -0,0 +1,191 @@
+package datagovsg
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// TrafficImagesKML returns a KML document of the traffic cameras in every
+// item of the snapshots, such as those returned by a series of date_time
+// queries. The balloon of each camera shows its image.
+//
+// Each item is placed in a folder and timed from its timestamp until the
+// timestamp of the next item, so that the series can be played back with
+// the time slider of Google Earth. A single item is timed by a TimeStamp
+// instead.
+func TrafficImagesKML(snapshots ...*TrafficImages) *geo.KML {
+	var frames []kmlFrame
+	for _, t := range snapshots {
+		for _, item := range t.Items {
+			frame := kmlFrame{timestamp: item.Timestamp}
+			for _, c := range item.Cameras {
+				description := fmt.Sprintf(
+					`<img src="%s" width="%d" height="%d"/><br/><a href="%[1]s">%[1]s</a><br/>Captured at %[4]s`,
+					html.EscapeString(c.Image), c.ImageMetadata.Width, c.ImageMetadata.Height, html.EscapeString(c.Timestamp),
+				)
+				frame.placemarks = append(frame.placemarks, geo.KMLPlacemark{
+					Name:        c.CameraID,
+					Description: &geo.KMLCDATA{Text: description},
+					ExtendedData: kmlData(
+						"camera_id", c.CameraID,
+						"timestamp", c.Timestamp,
+						"image", c.Image,
+						"image_md5", c.ImageMetadata.MD5,
+					),
+					Point: geo.NewKMLPoint(geo.Point{Latitude: c.Location.Latitude, Longitude: c.Location.Longitude}),
+				})
+			}
+			frames = append(frames, frame)
+		}
+	}
+	return kmlTimeline("Traffic Cameras", frames)
+}
+
+// WeatherStationsKML returns a KML document of the weather stations and
+// their readings in the given resources. Readings of different resources
+// made by the same station at the same time are combined into a single
+// placemark.
+//
+// Readings are grouped into folders by timestamp and timed like those of
+// TrafficImagesKML.
+func WeatherStationsKML(resources ...StationReadingLister) *geo.KML {
+	catalog := NewStationCatalog()
+	byTime := make(map[string]map[string][]StationReading)
+	for _, r := range resources {
+		catalog.Add(r.Stations()...)
+		for _, reading := range r.StationReadings() {
+			if byTime[reading.Timestamp] == nil {
+				byTime[reading.Timestamp] = make(map[string][]StationReading)
+			}
+			byTime[reading.Timestamp][reading.StationID] = append(byTime[reading.Timestamp][reading.StationID], reading)
+		}
+	}
+
+	var frames []kmlFrame
+	for ts, stations := range byTime {
+		frame := kmlFrame{timestamp: ts}
+		for _, s := range catalog.Stations() {
+			readings, ok := stations[s.ID]
+			if !ok {
+				continue
+			}
+			var description strings.Builder
+			data := []string{"station_id", s.ID, "timestamp", ts}
+			description.WriteString("<table>")
+			for _, r := range readings {
+				value := strconv.FormatFloat(r.Value, 'f', -1, 64)
+				fmt.Fprintf(&description, "<tr><th>%s</th><td>%s %s</td></tr>", r.Measurement, value, html.EscapeString(r.Unit))
+				data = append(data, string(r.Measurement), value)
+			}
+			description.WriteString("</table>")
+			frame.placemarks = append(frame.placemarks, geo.KMLPlacemark{
+				Name:         s.Name,
+				Description:  &geo.KMLCDATA{Text: description.String()},
+				ExtendedData: kmlData(data...),
+				Point:        geo.NewKMLPoint(geo.Point{Latitude: s.Latitude, Longitude: s.Longitude}),
+			})
+		}
+		frames = append(frames, frame)
+	}
+	return kmlTimeline("Weather Stations", frames)
+}
+
+// TaxiAvailabilityKML returns a KML document of the available taxis in
+// the snapshots, with each snapshot as a single placemark of points.
+//
+// Snapshots are timed like the items of TrafficImagesKML.
+func TaxiAvailabilityKML(snapshots ...*TaxiAvailability) *geo.KML {
+	var frames []kmlFrame
+	for _, t := range snapshots {
+		for i := range t.Features {
+			f := &t.Features[i]
+			frames = append(frames, kmlFrame{
+				timestamp: f.Properties.Timestamp,
+				placemarks: []geo.KMLPlacemark{{
+					Name: fmt.Sprintf("%d available taxis", f.Properties.TaxiCount),
+					ExtendedData: kmlData(
+						"timestamp", f.Properties.Timestamp,
+						"taxi_count", strconv.Itoa(f.Properties.TaxiCount),
+					),
+					MultiGeometry: geo.NewKMLMultiPoint(f.Points()),
+				}},
+			})
+		}
+	}
+	return kmlTimeline("Taxi Availability", frames)
+}
+
+// kmlFrame represents the placemarks of a snapshot at a point in time.
+type kmlFrame struct {
+	timestamp  string
+	placemarks []geo.KMLPlacemark
+}
+
+// kmlTimeline returns a KML document with a folder for each frame, in
+// chronological order. Frames with duplicate timestamps are dropped.
+//
+// The placemarks of each frame are timed from the timestamp of the frame
+// until the timestamp of the next frame, with the last frame left open
+// ended. If there is only one frame, its placemarks are timed by a
+// TimeStamp instead.
+func kmlTimeline(name string, frames []kmlFrame) *geo.KML {
+	sort.SliceStable(frames, func(i, j int) bool {
+		return kmlTimeBefore(frames[i].timestamp, frames[j].timestamp)
+	})
+	unique := frames[:0]
+	for _, f := range frames {
+		if len(unique) == 0 || f.timestamp != unique[len(unique)-1].timestamp {
+			unique = append(unique, f)
+		}
+	}
+	frames = unique
+
+	k := geo.NewKML(name)
+	for i, f := range frames {
+		var stamp *geo.KMLTimeStamp
+		var span *geo.KMLTimeSpan
+		switch {
+		case len(frames) == 1:
+			stamp = &geo.KMLTimeStamp{When: f.timestamp}
+		case i+1 < len(frames):
+			span = &geo.KMLTimeSpan{Begin: f.timestamp, End: frames[i+1].timestamp}
+		default:
+			span = &geo.KMLTimeSpan{Begin: f.timestamp}
+		}
+		for j := range f.placemarks {
+			f.placemarks[j].TimeStamp = stamp
+			f.placemarks[j].TimeSpan = span
+		}
+		k.Document.Folders = append(k.Document.Folders, geo.KMLFolder{
+			Name:       f.timestamp,
+			Placemarks: f.placemarks,
+		})
+	}
+	return k
+}
+
+// kmlTimeBefore returns true if timestamp a is before timestamp b,
+// comparing them as strings if either cannot be parsed.
+func kmlTimeBefore(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return ta.Before(tb)
+}
+
+// kmlData returns the extended data of alternating names and values.
+func kmlData(pairs ...string) *geo.KMLExtendedData {
+	d := &geo.KMLExtendedData{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		d.Data = append(d.Data, geo.KMLData{Name: pairs[i], Value: pairs[i+1]})
+	}
+	return d
+}
@@ -0,0 +1,122 @@
+package datagovsg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// assertKMLEncodes checks that the KML document encodes into well-formed
+// XML.
+func assertKMLEncodes(t *testing.T, k *geo.KML) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := k.Encode(&buf); err != nil {
+		t.Fatalf("error encoding kml: %v", err)
+	}
+	var decoded geo.KML
+	if err := xml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("error decoding kml: %v", err)
+	}
+	return buf.String()
+}
+
+func TestTrafficImagesKML(t *testing.T) {
+	var images TrafficImages
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", &images)
+	later := TrafficImages{Items: []TrafficImagesItem{images.Items[0]}}
+	later.Items[0].Timestamp = "2020-06-27T14:13:59+08:00"
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		snapshots []*TrafficImages
+		folders   int
+		stamp     bool
+	}{
+		{"single_snapshot", []*TrafficImages{&images}, 1, true},
+		{"series", []*TrafficImages{&later, &images}, 2, false},
+		{"duplicate_snapshots", []*TrafficImages{&images, &images}, 1, true},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			k := TrafficImagesKML(tc.snapshots...)
+			folders := k.Document.Folders
+			if len(folders) != tc.folders {
+				t.Fatalf("got %v folders want %v", len(folders), tc.folders)
+			}
+			p := folders[0].Placemarks[0]
+			if got, want := len(folders[0].Placemarks), len(images.Items[0].Cameras); got != want {
+				t.Errorf("got %v placemarks want %v", got, want)
+			}
+			if p.Name != "1701" || !strings.Contains(p.Description.Text, `<img src="https://images.data.gov.sg/`) {
+				t.Errorf("got %+v want camera 1701 with image", p)
+			}
+			if tc.stamp {
+				if p.TimeStamp == nil || p.TimeStamp.When != "2020-06-27T14:12:59+08:00" || p.TimeSpan != nil {
+					t.Errorf("got (%+v, %+v) want timestamp", p.TimeStamp, p.TimeSpan)
+				}
+			} else {
+				want := geo.KMLTimeSpan{Begin: "2020-06-27T14:12:59+08:00", End: "2020-06-27T14:13:59+08:00"}
+				if p.TimeSpan == nil || *p.TimeSpan != want || p.TimeStamp != nil {
+					t.Errorf("got (%+v, %+v) want %+v", p.TimeStamp, p.TimeSpan, want)
+				}
+				last := folders[1].Placemarks[0].TimeSpan
+				if last == nil || *last != (geo.KMLTimeSpan{Begin: "2020-06-27T14:13:59+08:00"}) {
+					t.Errorf("got %+v want open ended time span", last)
+				}
+			}
+			assertKMLEncodes(t, k)
+		})
+	}
+}
+
+func TestWeatherStationsKML(t *testing.T) {
+	var airTemperature AirTemperature
+	loadFixture(t, "testdata/fixtures/environment_airtemperature_default.json", &airTemperature)
+	var relativeHumidity RelativeHumidity
+	loadFixture(t, "testdata/fixtures/environment_relativehumidity_default.json", &relativeHumidity)
+
+	k := WeatherStationsKML(&airTemperature, &relativeHumidity)
+	if len(k.Document.Folders) == 0 {
+		t.Fatalf("got no folders")
+	}
+	var combined bool
+	for _, folder := range k.Document.Folders {
+		for _, p := range folder.Placemarks {
+			if strings.Contains(p.Description.Text, string(MeasurementAirTemperature)) &&
+				strings.Contains(p.Description.Text, string(MeasurementRelativeHumidity)) {
+				combined = true
+			}
+		}
+	}
+	if !combined {
+		t.Errorf("got no placemark combining air temperature and relative humidity")
+	}
+	out := assertKMLEncodes(t, k)
+	if !strings.Contains(out, "<name>Weather Stations</name>") {
+		t.Errorf("got %v want document name", out)
+	}
+}
+
+func TestTaxiAvailabilityKML(t *testing.T) {
+	var taxis TaxiAvailability
+	loadFixture(t, "testdata/fixtures/transport_taxiavailability_default.json", &taxis)
+	k := TaxiAvailabilityKML(&taxis)
+	if len(k.Document.Folders) != 1 || len(k.Document.Folders[0].Placemarks) != 1 {
+		t.Fatalf("got %+v want a single placemark", k.Document)
+	}
+	p := k.Document.Folders[0].Placemarks[0]
+	if p.Name != "5093 available taxis" || len(p.MultiGeometry.Points) != 2 {
+		t.Errorf("got %+v want 2 points", p)
+	}
+	assertKMLEncodes(t, k)
+}
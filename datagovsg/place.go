@@ -0,0 +1,169 @@
+package datagovsg
+
+import (
+	"sort"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// PlaceKind represents the type of a Place.
+type PlaceKind string
+
+const (
+	// PlaceKindStation represents a weather station.
+	PlaceKindStation PlaceKind = "station"
+
+	// PlaceKindCamera represents a traffic camera.
+	PlaceKindCamera PlaceKind = "camera"
+
+	// PlaceKindForecastArea represents a 2-hour weather forecast area.
+	PlaceKindForecastArea PlaceKind = "forecast_area"
+
+	// PlaceKindRegion represents a PSI or PM2.5 region.
+	PlaceKindRegion PlaceKind = "region"
+)
+
+// Place represents a named location found in a resource.
+type Place struct {
+	// Type of the place
+	Kind PlaceKind
+
+	// Identifier of the place within its kind, e.g. a station or camera ID
+	ID string
+
+	// Name of the place
+	Name string
+
+	// Latitude of the place
+	Latitude float64
+
+	// Longitude of the place
+	Longitude float64
+}
+
+// Point returns the location of the place.
+func (p Place) Point() geo.Point {
+	return geo.Point{Latitude: p.Latitude, Longitude: p.Longitude}
+}
+
+// PlaceDistance represents a place found by a spatial query.
+type PlaceDistance struct {
+	Place
+
+	// Distance to the query location in metres
+	Distance float64
+}
+
+// PlaceIndex is a spatial index over places supporting nearest and
+// within-radius queries.
+type PlaceIndex struct {
+	places []Place
+	index  *geo.Index
+}
+
+// NewPlaceIndex returns a new PlaceIndex over the places.
+func NewPlaceIndex(places []Place) *PlaceIndex {
+	points := make([]geo.Point, len(places))
+	for i, p := range places {
+		points[i] = p.Point()
+	}
+	return &PlaceIndex{
+		places: append([]Place(nil), places...),
+		index:  geo.NewIndex(points),
+	}
+}
+
+// Len returns the number of places in the index.
+func (ix *PlaceIndex) Len() int {
+	return len(ix.places)
+}
+
+// Nearest returns the k nearest places to the given coordinates, ordered
+// by increasing distance.
+func (ix *PlaceIndex) Nearest(latitude, longitude float64, k int) []PlaceDistance {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	return ix.resolve(ix.index.Nearest(p, k))
+}
+
+// Within returns the places within radius metres of the given coordinates,
+// ordered by increasing distance.
+func (ix *PlaceIndex) Within(latitude, longitude, radius float64) []PlaceDistance {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	return ix.resolve(ix.index.Within(p, radius))
+}
+
+// resolve converts the neighbors into places.
+func (ix *PlaceIndex) resolve(neighbors []geo.Neighbor) []PlaceDistance {
+	if len(neighbors) == 0 {
+		return nil
+	}
+	places := make([]PlaceDistance, len(neighbors))
+	for i, n := range neighbors {
+		places[i] = PlaceDistance{ix.places[n.Index], n.Distance}
+	}
+	return places
+}
+
+// Places returns the stations in the catalogue as places.
+func (c *StationCatalog) Places() []Place {
+	stations := c.Stations()
+	places := make([]Place, len(stations))
+	for i, s := range stations {
+		places[i] = Place{PlaceKindStation, s.ID, s.Name, s.Latitude, s.Longitude}
+	}
+	return places
+}
+
+// Places returns the traffic cameras as places. If a camera appears in
+// multiple items, its latest location is used.
+func (t *TrafficImages) Places() []Place {
+	cameras := make(map[string]Place)
+	for _, item := range t.Items {
+		for _, c := range item.Cameras {
+			cameras[c.CameraID] = Place{PlaceKindCamera, c.CameraID, c.CameraID, c.Location.Latitude, c.Location.Longitude}
+		}
+	}
+	places := make([]Place, 0, len(cameras))
+	for _, p := range cameras {
+		places = append(places, p)
+	}
+	sort.Slice(places, func(i, j int) bool {
+		return places[i].ID < places[j].ID
+	})
+	return places
+}
+
+// Places returns the label locations of the forecast areas as places.
+func (f *TwoHourWeatherForecast) Places() []Place {
+	places := make([]Place, len(f.AreaMetadata))
+	for i, a := range f.AreaMetadata {
+		places[i] = Place{PlaceKindForecastArea, a.Name, a.Name, a.LabelLocation.Latitude, a.LabelLocation.Longitude}
+	}
+	return places
+}
+
+// Places returns the label locations of the regions as places. The
+// national region is excluded as it has no location.
+func (p *PSI) Places() []Place {
+	var places []Place
+	for _, r := range p.RegionMetadata {
+		if r.Name == "national" {
+			continue
+		}
+		places = append(places, Place{PlaceKindRegion, r.Name, r.Name, r.LabelLocation.Latitude, r.LabelLocation.Longitude})
+	}
+	return places
+}
+
+// Places returns the label locations of the regions as places. The
+// national region is excluded as it has no location.
+func (p *PM25) Places() []Place {
+	var places []Place
+	for _, r := range p.RegionMetadata {
+		if r.Name == "national" {
+			continue
+		}
+		places = append(places, Place{PlaceKindRegion, r.Name, r.Name, r.LabelLocation.Latitude, r.LabelLocation.Longitude})
+	}
+	return places
+}
@@ -0,0 +1,100 @@
+package datagovsg
+
+import (
+	"testing"
+)
+
+// loadPlaceIndex returns a PlaceIndex built from the fixtures of all
+// location-bearing resources.
+func loadPlaceIndex(t *testing.T) *PlaceIndex {
+	t.Helper()
+	ti := &TrafficImages{}
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", ti)
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	psi := &PSI{}
+	loadFixture(t, "testdata/fixtures/environment_psi_default.json", psi)
+
+	var places []Place
+	places = append(places, loadStationCatalog(t).Places()...)
+	places = append(places, ti.Places()...)
+	places = append(places, f.Places()...)
+	places = append(places, psi.Places()...)
+	return NewPlaceIndex(places)
+}
+
+func TestPlaceIndex_Nearest(t *testing.T) {
+	ix := loadPlaceIndex(t)
+	if got, want := ix.Len(), 51+2+47+5; got != want {
+		t.Errorf("got %v places want %v", got, want)
+	}
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		kind      PlaceKind
+		id        string
+	}{
+		{"station", 1.3337, 103.7768, PlaceKindStation, "S50"},
+		{"camera", 1.323604823, 103.8587802, PlaceKindCamera, "1701"},
+		{"forecast_area", 1.37, 103.948, PlaceKindForecastArea, "Pasir Ris"},
+		{"region", 1.29587, 103.82, PlaceKindRegion, "south"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ix.Nearest(tc.latitude, tc.longitude, 3)
+			if len(got) != 3 {
+				t.Fatalf("got %v places want %v", len(got), 3)
+			}
+			if got[0].Kind != tc.kind || got[0].ID != tc.id || got[0].Distance > 1 {
+				t.Errorf("got %+v want %v %v", got[0], tc.kind, tc.id)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Distance < got[i-1].Distance {
+					t.Errorf("places not ordered by distance: %+v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaceIndex_Within(t *testing.T) {
+	ix := loadPlaceIndex(t)
+
+	// Assert both cameras along the CTE are found within 2.5km of each other
+	got := ix.Within(1.323604823, 103.8587802, 2500)
+	var cameras []string
+	for _, p := range got {
+		if p.Distance > 2500 {
+			t.Errorf("got place %+v outside radius", p)
+		}
+		if p.Kind == PlaceKindCamera {
+			cameras = append(cameras, p.ID)
+		}
+	}
+	if len(cameras) != 2 || cameras[0] != "1701" || cameras[1] != "1702" {
+		t.Errorf("got cameras %v want %v", cameras, []string{"1701", "1702"})
+	}
+
+	// Assert no places are found in the sea
+	if got := ix.Within(1.1, 104.3, 5000); got != nil {
+		t.Errorf("expected no places but got: %+v", got)
+	}
+}
+
+func TestPSI_Places(t *testing.T) {
+	psi := &PSI{}
+	loadFixture(t, "testdata/fixtures/environment_psi_default.json", psi)
+	for _, p := range psi.Places() {
+		if p.ID == "national" {
+			t.Errorf("expected national region to be excluded but got: %+v", p)
+		}
+	}
+}
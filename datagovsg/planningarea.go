@@ -0,0 +1,282 @@
+package datagovsg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+//go:generate go run planningarea_gen.go
+
+const (
+	// DatasetIDMasterPlan2019Subzones is the dataset ID of the URA Master
+	// Plan 2019 subzone boundaries (no sea) in GeoJSON.
+	DatasetIDMasterPlan2019Subzones = "d_8594ae9ff96d0c708bc2af633048edfb"
+)
+
+var (
+	// ErrBoundaryDecode is returned by LoadPlanningAreas calls when the
+	// boundaries could not be decoded.
+	ErrBoundaryDecode = errors.New("datagovsg: error decoding boundaries")
+
+	// ErrNoBoundaries is returned by DefaultPlanningAreas calls when the
+	// package was generated without any boundaries.
+	ErrNoBoundaries = errors.New("datagovsg: no boundaries shipped")
+)
+
+// The planning areas shipped with the package, decoded on first use.
+var (
+	defaultPlanningAreasOnce sync.Once
+	defaultPlanningAreas     *PlanningAreas
+	defaultPlanningAreasErr  error
+)
+
+// Subzone represents a URA Master Plan subzone.
+type Subzone struct {
+	// Name of the subzone, e.g. "TAMPINES EAST"
+	Name string
+
+	// Code of the subzone, e.g. "TMSZ02"
+	Code string
+
+	// Name of the planning area containing the subzone, e.g. "TAMPINES"
+	PlanningArea string
+
+	// Name of the region containing the subzone, e.g. "EAST REGION"
+	Region string
+
+	// Boundary of the subzone
+	Boundary geo.MultiPolygon
+}
+
+// PlanningAreaLocation represents the subzone, planning area and region
+// containing a location.
+type PlanningAreaLocation struct {
+	// Name of the subzone
+	Subzone string
+
+	// Name of the planning area
+	PlanningArea string
+
+	// Name of the region
+	Region string
+}
+
+// PlanningAreas locates coordinates within URA Master Plan subzones and
+// their planning areas.
+type PlanningAreas struct {
+	subzones map[string]*Subzone
+	names    []string
+	locator  *geo.Locator
+}
+
+// NewPlanningAreas returns a new PlanningAreas from copies of the given
+// subzones.
+func NewPlanningAreas(subzones []Subzone) *PlanningAreas {
+	pa := &PlanningAreas{
+		subzones: make(map[string]*Subzone, len(subzones)),
+		locator:  geo.NewLocator(),
+	}
+	for _, s := range subzones {
+		if _, ok := pa.subzones[s.Name]; ok {
+			continue
+		}
+		s := s
+		pa.subzones[s.Name] = &s
+		pa.names = append(pa.names, s.Name)
+		pa.locator.Add(s.Name, s.Boundary)
+	}
+	return pa
+}
+
+// DefaultPlanningAreas returns the URA Master Plan 2019 subzone boundaries
+// shipped with the package. The returned PlanningAreas is shared and must
+// not be modified. Client.GetPlanningAreas downloads the latest boundaries
+// instead.
+func DefaultPlanningAreas() (*PlanningAreas, error) {
+	defaultPlanningAreasOnce.Do(func() {
+		defaultPlanningAreas, defaultPlanningAreasErr = loadPlanningAreaData(planningAreaData)
+	})
+	return defaultPlanningAreas, defaultPlanningAreasErr
+}
+
+// loadPlanningAreaData reads subzone boundaries from gzipped GeoJSON
+// encoded in base64, as generated by planningarea_gen.go.
+func loadPlanningAreaData(s string) (*PlanningAreas, error) {
+	zr, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(s)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBoundaryDecode, err)
+	}
+	defer zr.Close()
+	pa, err := LoadPlanningAreas(zr)
+	if err != nil {
+		return nil, err
+	}
+	if len(pa.names) == 0 {
+		return nil, ErrNoBoundaries
+	}
+	return pa, nil
+}
+
+// LoadPlanningAreas reads URA Master Plan subzone boundaries from a GeoJSON
+// FeatureCollection.
+//
+// The subzone, planning area and region names are read from the SUBZONE_N,
+// SUBZONE_C, PLN_AREA_N and REGION_N properties, either as properties of
+// their own or within the HTML table of the Description property used by
+// the data.gov.sg exports.
+func LoadPlanningAreas(r io.Reader) (*PlanningAreas, error) {
+	var fc geo.FeatureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBoundaryDecode, err)
+	}
+	subzones := make([]Subzone, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		props := boundaryAttributes(f)
+		if f.Geometry == nil {
+			return nil, fmt.Errorf("%w: subzone %q has no geometry", ErrBoundaryDecode, props["SUBZONE_N"])
+		}
+		boundary, err := f.Geometry.MultiPolygon()
+		if err != nil {
+			return nil, fmt.Errorf("%w: subzone %q: %v", ErrBoundaryDecode, props["SUBZONE_N"], err)
+		}
+		subzones = append(subzones, Subzone{
+			Name:         props["SUBZONE_N"],
+			Code:         props["SUBZONE_C"],
+			PlanningArea: props["PLN_AREA_N"],
+			Region:       props["REGION_N"],
+			Boundary:     boundary,
+		})
+	}
+	return NewPlanningAreas(subzones), nil
+}
+
+// GetPlanningAreas downloads the latest URA Master Plan 2019 subzone
+// boundaries and returns them as PlanningAreas, refreshing those shipped
+// with the package by DefaultPlanningAreas.
+func (c *Client) GetPlanningAreas(ctx context.Context) (*PlanningAreas, error) {
+	var buf bytes.Buffer
+	if err := c.DownloadDataset(ctx, DatasetIDMasterPlan2019Subzones, &buf); err != nil {
+		return nil, err
+	}
+	return LoadPlanningAreas(&buf)
+}
+
+// Subzones returns the subzones in the order they were loaded.
+func (pa *PlanningAreas) Subzones() []Subzone {
+	subzones := make([]Subzone, len(pa.names))
+	for i, name := range pa.names {
+		subzones[i] = *pa.subzones[name]
+	}
+	return subzones
+}
+
+// Subzone returns a subzone by its name.
+func (pa *PlanningAreas) Subzone(name string) (Subzone, bool) {
+	s, ok := pa.subzones[name]
+	if !ok {
+		return Subzone{}, false
+	}
+	return *s, true
+}
+
+// PlanningAreaNames returns the sorted names of the planning areas.
+func (pa *PlanningAreas) PlanningAreaNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, s := range pa.subzones {
+		if !seen[s.PlanningArea] {
+			seen[s.PlanningArea] = true
+			names = append(names, s.PlanningArea)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Locate returns the subzone, planning area and region containing a
+// location.
+func (pa *PlanningAreas) Locate(latitude, longitude float64) (PlanningAreaLocation, bool) {
+	name, ok := pa.locator.Locate(geo.Point{Latitude: latitude, Longitude: longitude})
+	if !ok {
+		return PlanningAreaLocation{}, false
+	}
+	s := pa.subzones[name]
+	return PlanningAreaLocation{
+		Subzone:      s.Name,
+		PlanningArea: s.PlanningArea,
+		Region:       s.Region,
+	}, true
+}
+
+// CountTaxis returns the number of available taxis in each planning area.
+// Taxis outside all planning areas are not counted.
+func (pa *PlanningAreas) CountTaxis(t *TaxiAvailability) map[string]int {
+	counts := make(map[string]int)
+	for _, p := range t.Points() {
+		if loc, ok := pa.Locate(p.Latitude, p.Longitude); ok {
+			counts[loc.PlanningArea]++
+		}
+	}
+	return counts
+}
+
+// GroupPlaces groups places, such as stations from StationCatalog.Places
+// or cameras from TrafficImages.Places, by planning area. Places outside
+// all planning areas are omitted.
+func (pa *PlanningAreas) GroupPlaces(places []Place) map[string][]Place {
+	groups := make(map[string][]Place)
+	for _, p := range places {
+		if loc, ok := pa.Locate(p.Latitude, p.Longitude); ok {
+			groups[loc.PlanningArea] = append(groups[loc.PlanningArea], p)
+		}
+	}
+	return groups
+}
+
+// GroupCarparks groups enriched carparks by planning area. Carparks
+// without information or outside all planning areas are omitted.
+func (pa *PlanningAreas) GroupCarparks(carparks []EnrichedCarpark) map[string][]EnrichedCarpark {
+	groups := make(map[string][]EnrichedCarpark)
+	for _, c := range carparks {
+		if c.Information == nil {
+			continue
+		}
+		if loc, ok := pa.Locate(c.Information.Latitude, c.Information.Longitude); ok {
+			groups[loc.PlanningArea] = append(groups[loc.PlanningArea], c)
+		}
+	}
+	return groups
+}
+
+// descriptionAttributePattern matches the attribute rows of the HTML table
+// in the Description property.
+var descriptionAttributePattern = regexp.MustCompile(`<th>\s*([^<]+?)\s*</th>\s*<td>\s*([^<]*?)\s*</td>`)
+
+// boundaryAttributes returns the string properties of a feature, including
+// those within the HTML table of the Description property.
+func boundaryAttributes(f geo.Feature) map[string]string {
+	attrs := make(map[string]string)
+	for k, v := range f.Properties {
+		if s, ok := v.(string); ok {
+			attrs[strings.ToUpper(k)] = s
+		}
+	}
+	for _, m := range descriptionAttributePattern.FindAllStringSubmatch(attrs["DESCRIPTION"], -1) {
+		if _, ok := attrs[m[1]]; !ok {
+			attrs[m[1]] = m[2]
+		}
+	}
+	return attrs
+}
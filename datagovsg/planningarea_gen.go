@@ -0,0 +1,93 @@
+// +build ignore
+
+// This program generates planningarea_data.go, which holds the URA Master
+// Plan 2019 subzone boundaries shipped with the package. It downloads the
+// boundaries from data.gov.sg, or reads them from a GeoJSON file given by
+// the -input flag. Invoke it with go generate.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg"
+)
+
+// The number of base64 characters per line of the generated constant.
+const lineLength = 76
+
+func main() {
+	input := flag.String("input", "", "read boundaries from a GeoJSON file instead of downloading them")
+	output := flag.String("output", "planningarea_data.go", "file to write")
+	flag.Parse()
+
+	// Read boundaries
+	var data []byte
+	var err error
+	if *input != "" {
+		data, err = ioutil.ReadFile(*input)
+	} else {
+		var buf bytes.Buffer
+		err = datagovsg.NewClient().DownloadDataset(context.Background(), datagovsg.DatasetIDMasterPlan2019Subzones, &buf)
+		data = buf.Bytes()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Validate boundaries
+	pa, err := datagovsg.LoadPlanningAreas(bytes.NewReader(data))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Compress and encode boundaries
+	var gz bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := zw.Write(data); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(gz.Bytes())
+
+	// Write file
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	if err := write(f, encoded, len(pa.Subzones())); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// write writes the generated file holding the encoded boundaries.
+func write(w io.Writer, encoded string, subzones int) error {
+	var b strings.Builder
+	b.WriteString("// Code generated by planningarea_gen.go; DO NOT EDIT.\n\n")
+	b.WriteString("package datagovsg\n\n")
+	fmt.Fprintf(&b, "// planningAreaData holds the gzipped GeoJSON of %d URA Master Plan 2019\n", subzones)
+	b.WriteString("// subzone boundaries, encoded in base64.\n")
+	b.WriteString("const planningAreaData = \"\" +\n")
+	for len(encoded) > lineLength {
+		fmt.Fprintf(&b, "\t%q +\n", encoded[:lineLength])
+		encoded = encoded[lineLength:]
+	}
+	fmt.Fprintf(&b, "\t%q\n", encoded)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,20 @@
+// +build integration
+
+package datagovsg
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPlanningAreas(t *testing.T) {
+	// Execute request
+	c := NewClient()
+	pa, err := c.GetPlanningAreas(context.Background())
+	if err != nil {
+		t.Fatalf("error executing request: %v", err)
+	}
+	if _, ok := pa.Locate(1.3526, 103.8352); !ok {
+		t.Errorf("got no planning area for bishan")
+	}
+}
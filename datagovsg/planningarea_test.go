@@ -0,0 +1,224 @@
+package datagovsg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// loadPlanningAreas returns planning areas loaded from the fixture.
+func loadPlanningAreas(t *testing.T) *PlanningAreas {
+	t.Helper()
+	f, err := os.Open("testdata/fixtures/planningarea_default.json")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %v", err)
+	}
+	defer f.Close()
+	pa, err := LoadPlanningAreas(f)
+	if err != nil {
+		t.Fatalf("failed to load planning areas: %v", err)
+	}
+	return pa
+}
+
+func TestLoadPlanningAreas(t *testing.T) {
+	pa := loadPlanningAreas(t)
+	subzones := pa.Subzones()
+	if len(subzones) != 3 {
+		t.Fatalf("got %v subzones want %v", len(subzones), 3)
+	}
+	want := Subzone{
+		Name:         "TAMPINES EAST",
+		Code:         "TMSZ02",
+		PlanningArea: "TAMPINES",
+		Region:       "EAST REGION",
+	}
+	got := subzones[0]
+	if len(got.Boundary) != 1 || len(got.Boundary[0]) != 2 {
+		t.Errorf("got boundary %+v want one polygon with a hole", got.Boundary)
+	}
+	got.Boundary = nil
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if got, want := pa.PlanningAreaNames(), []string{"DOWNTOWN CORE", "TAMPINES"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestLoadPlanningAreas_Error(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"invalid_json", `{"features":`},
+		{"unsupported_geometry", `{"features":[{"properties":{},"geometry":{"type":"Point","coordinates":[103.8,1.3]}}]}`},
+		{"invalid_coordinates", `{"features":[{"properties":{},"geometry":{"type":"Polygon","coordinates":[1,2]}}]}`},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LoadPlanningAreas(strings.NewReader(tc.input))
+			if !errors.Is(err, ErrBoundaryDecode) {
+				t.Errorf("got %v want %v", err, ErrBoundaryDecode)
+			}
+		})
+	}
+}
+
+func TestNewPlanningAreas(t *testing.T) {
+	// Assert subzones are copied
+	subzones := []Subzone{{Name: "TAMPINES EAST", PlanningArea: "TAMPINES"}}
+	pa := NewPlanningAreas(subzones)
+	subzones[0].PlanningArea = "BEDOK"
+	if got, _ := pa.Subzone("TAMPINES EAST"); got.PlanningArea != "TAMPINES" {
+		t.Errorf("got %+v want %+v", got.PlanningArea, "TAMPINES")
+	}
+}
+
+func TestLoadPlanningAreaData(t *testing.T) {
+	// Encode fixture as generated by planningarea_gen.go
+	b, err := ioutil.ReadFile("testdata/fixtures/planningarea_default.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write(b)
+	zw.Close()
+
+	// Create test cases
+	cases := []struct {
+		name     string
+		data     string
+		subzones int
+		err      error
+	}{
+		{"fixture", base64.StdEncoding.EncodeToString(gz.Bytes()), 3, nil},
+		{"empty", "H4sIAAAAAAAC/6pWKqksSFWyUnJLTSwpLUp1zs/JSU0uyczPU9JRSoOIFStZRcfWcgEGAMXVnhErAAAA", 0, ErrNoBoundaries},
+		{"invalid", "not base64", 0, ErrBoundaryDecode},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pa, err := loadPlanningAreaData(tc.data)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error '%v' but got: %v", tc.err, err)
+			}
+			if err == nil && len(pa.Subzones()) != tc.subzones {
+				t.Errorf("got %v subzones want %v", len(pa.Subzones()), tc.subzones)
+			}
+		})
+	}
+}
+
+func TestDefaultPlanningAreas(t *testing.T) {
+	// Assert the shipped boundaries can be decoded
+	pa, err := DefaultPlanningAreas()
+	if errors.Is(err, ErrNoBoundaries) {
+		t.Fatalf("package generated without boundaries, run go generate to download them")
+	}
+	if err != nil {
+		t.Fatalf("expected no errors but got: %v", err)
+	}
+	if _, ok := pa.Locate(1.3526, 103.8352); !ok {
+		t.Errorf("got no planning area for bishan")
+	}
+}
+
+func TestPlanningAreas_Locate(t *testing.T) {
+	pa := loadPlanningAreas(t)
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      PlanningAreaLocation
+		ok        bool
+	}{
+		{"polygon", 1.36, 103.96, PlanningAreaLocation{"TAMPINES EAST", "TAMPINES", "EAST REGION"}, true},
+		{"hole", 1.352, 103.952, PlanningAreaLocation{"TAMPINES WEST", "TAMPINES", "EAST REGION"}, true},
+		{"multipolygon", 1.29, 103.85, PlanningAreaLocation{"CITY HALL", "DOWNTOWN CORE", "CENTRAL REGION"}, true},
+		{"multipolygon_island", 1.205, 103.845, PlanningAreaLocation{"CITY HALL", "DOWNTOWN CORE", "CENTRAL REGION"}, true},
+		{"outside", 1.45, 103.80, PlanningAreaLocation{}, false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := pa.Locate(tc.latitude, tc.longitude)
+			if got != tc.want || ok != tc.ok {
+				t.Errorf("got (%+v, %v) want (%+v, %v)", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestPlanningAreas_CountTaxis(t *testing.T) {
+	pa := loadPlanningAreas(t)
+	taxis := &TaxiAvailability{
+		Features: []TaxiAvailabilityFeature{
+			{
+				Geometry: TaxiAvailabilityFeatureGeometry{
+					Coordinates: []TaxiAvailabilityFeatureGeometryCoordinates{
+						{103.96, 1.36},
+						{103.952, 1.352},
+						{103.85, 1.29},
+						{103.80, 1.45},
+					},
+				},
+			},
+		},
+	}
+	want := map[string]int{"TAMPINES": 2, "DOWNTOWN CORE": 1}
+	if got := pa.CountTaxis(taxis); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestPlanningAreas_GroupPlaces(t *testing.T) {
+	pa := loadPlanningAreas(t)
+	places := []Place{
+		{PlaceKindCamera, "1001", "1001", 1.29, 103.85},
+		{PlaceKindStation, "S24", "Upper Changi Road North", 1.36, 103.96},
+		{PlaceKindStation, "S104", "Woodlands Avenue 9", 1.45, 103.80},
+	}
+	want := map[string][]Place{
+		"DOWNTOWN CORE": {places[0]},
+		"TAMPINES":      {places[1]},
+	}
+	if got := pa.GroupPlaces(places); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestPlanningAreas_GroupCarparks(t *testing.T) {
+	pa := loadPlanningAreas(t)
+	carparks := []EnrichedCarpark{
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "T1"}, Information: &CarparkInformation{Latitude: 1.36, Longitude: 103.96}},
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "X1"}},
+	}
+	want := map[string][]EnrichedCarpark{"TAMPINES": {carparks[0]}}
+	if got := pa.GroupCarparks(carparks); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
@@ -0,0 +1,330 @@
+package datagovsg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+const (
+	// RegionNational is the key of the readings aggregated across Singapore.
+	RegionNational = "national"
+
+	// RegionCentral is the key of the central region.
+	RegionCentral = "central"
+
+	// RegionEast is the key of the east region.
+	RegionEast = "east"
+
+	// RegionNorth is the key of the north region.
+	RegionNorth = "north"
+
+	// RegionSouth is the key of the south region.
+	RegionSouth = "south"
+
+	// RegionWest is the key of the west region.
+	RegionWest = "west"
+)
+
+var (
+	// ErrOutsideRegions is returned when a location does not lie within any
+	// of the PSI and PM2.5 regions.
+	ErrOutsideRegions = errors.New("datagovsg: location outside regions")
+
+	// ErrNoReadings is returned when a resource does not contain any readings.
+	ErrNoReadings = errors.New("datagovsg: no readings")
+)
+
+// mainlandBoundary is a simplified outline of the main island of
+// Singapore, including Jurong Island. It is drawn a few hundred metres
+// offshore, so that locations on the shore lie within it.
+var mainlandBoundary = geo.Ring{
+	{Latitude: 1.24, Longitude: 103.615},
+	{Latitude: 1.295, Longitude: 103.6},
+	{Latitude: 1.33, Longitude: 103.605},
+	{Latitude: 1.353, Longitude: 103.633},
+	{Latitude: 1.37, Longitude: 103.643},
+	{Latitude: 1.39, Longitude: 103.656},
+	{Latitude: 1.41, Longitude: 103.669},
+	{Latitude: 1.43, Longitude: 103.685},
+	{Latitude: 1.443, Longitude: 103.7},
+	{Latitude: 1.448, Longitude: 103.715},
+	{Latitude: 1.451, Longitude: 103.73},
+	{Latitude: 1.449, Longitude: 103.745},
+	{Latitude: 1.456, Longitude: 103.76},
+	{Latitude: 1.458, Longitude: 103.77},
+	{Latitude: 1.458, Longitude: 103.785},
+	{Latitude: 1.463, Longitude: 103.8},
+	{Latitude: 1.473, Longitude: 103.815},
+	{Latitude: 1.47, Longitude: 103.83},
+	{Latitude: 1.464, Longitude: 103.84},
+	{Latitude: 1.45, Longitude: 103.855},
+	{Latitude: 1.435, Longitude: 103.87},
+	{Latitude: 1.425, Longitude: 103.885},
+	{Latitude: 1.423, Longitude: 103.905},
+	{Latitude: 1.418, Longitude: 103.92},
+	{Latitude: 1.395, Longitude: 103.935},
+	{Latitude: 1.389, Longitude: 103.955},
+	{Latitude: 1.388, Longitude: 103.97},
+	{Latitude: 1.395, Longitude: 103.985},
+	{Latitude: 1.395, Longitude: 103.995},
+	{Latitude: 1.38, Longitude: 104.01},
+	{Latitude: 1.37, Longitude: 104.035},
+	{Latitude: 1.33, Longitude: 104.04},
+	{Latitude: 1.315, Longitude: 103.99},
+	{Latitude: 1.303, Longitude: 103.96},
+	{Latitude: 1.297, Longitude: 103.93},
+	{Latitude: 1.295, Longitude: 103.91},
+	{Latitude: 1.285, Longitude: 103.885},
+	{Latitude: 1.275, Longitude: 103.872},
+	{Latitude: 1.262, Longitude: 103.862},
+	{Latitude: 1.26, Longitude: 103.845},
+	{Latitude: 1.261, Longitude: 103.825},
+	{Latitude: 1.2625, Longitude: 103.805},
+	{Latitude: 1.264, Longitude: 103.78},
+	{Latitude: 1.27, Longitude: 103.76},
+	{Latitude: 1.28, Longitude: 103.745},
+	{Latitude: 1.27, Longitude: 103.725},
+	{Latitude: 1.245, Longitude: 103.71},
+	{Latitude: 1.23, Longitude: 103.685},
+	{Latitude: 1.235, Longitude: 103.665},
+	{Latitude: 1.25, Longitude: 103.65},
+	{Latitude: 1.245, Longitude: 103.635},
+}
+
+// Simplified outlines of the outlying islands of Singapore.
+var (
+	sentosaBoundary = geo.Ring{
+		{Latitude: 1.2585, Longitude: 103.8055},
+		{Latitude: 1.2595, Longitude: 103.827},
+		{Latitude: 1.253, Longitude: 103.8445},
+		{Latitude: 1.244, Longitude: 103.8455},
+		{Latitude: 1.24, Longitude: 103.833},
+		{Latitude: 1.241, Longitude: 103.815},
+		{Latitude: 1.249, Longitude: 103.804},
+	}
+	southernIslandsBoundary = geo.Ring{
+		{Latitude: 1.23, Longitude: 103.825},
+		{Latitude: 1.23, Longitude: 103.87},
+		{Latitude: 1.2, Longitude: 103.87},
+		{Latitude: 1.2, Longitude: 103.825},
+	}
+	westernIslandsBoundary = geo.Ring{
+		{Latitude: 1.24, Longitude: 103.7},
+		{Latitude: 1.24, Longitude: 103.785},
+		{Latitude: 1.19, Longitude: 103.785},
+		{Latitude: 1.19, Longitude: 103.7},
+	}
+	pulauUbinBoundary = geo.Ring{
+		{Latitude: 1.42, Longitude: 103.92},
+		{Latitude: 1.425, Longitude: 103.96},
+		{Latitude: 1.42, Longitude: 104.0},
+		{Latitude: 1.4, Longitude: 103.995},
+		{Latitude: 1.397, Longitude: 103.96},
+		{Latitude: 1.405, Longitude: 103.925},
+	}
+	pulauTekongBoundary = geo.Ring{
+		{Latitude: 1.43, Longitude: 104.02},
+		{Latitude: 1.435, Longitude: 104.06},
+		{Latitude: 1.415, Longitude: 104.09},
+		{Latitude: 1.39, Longitude: 104.085},
+		{Latitude: 1.385, Longitude: 104.05},
+		{Latitude: 1.4, Longitude: 104.02},
+	}
+)
+
+// singaporeBoundary is a simplified outline of Singapore, made up of the
+// main island and the outlying islands.
+var singaporeBoundary = geo.MultiPolygon{
+	{mainlandBoundary},
+	{sentosaBoundary},
+	{southernIslandsBoundary},
+	{westernIslandsBoundary},
+	{pulauUbinBoundary},
+	{pulauTekongBoundary},
+}
+
+// regions are the keys of the PSI and PM2.5 regions in alphabetical order.
+var regions = []string{RegionCentral, RegionEast, RegionNorth, RegionSouth, RegionWest}
+
+// regionPartitions are convex rings partitioning the surroundings of
+// Singapore among the PSI and PM2.5 regions.
+var regionPartitions = map[string]geo.Ring{
+	RegionCentral: {
+		{Latitude: 1.4, Longitude: 103.76},
+		{Latitude: 1.4, Longitude: 103.9},
+		{Latitude: 1.305, Longitude: 103.885},
+		{Latitude: 1.305, Longitude: 103.76},
+	},
+	RegionEast: {
+		{Latitude: 1, Longitude: 103.885},
+		{Latitude: 1.305, Longitude: 103.885},
+		{Latitude: 1.4, Longitude: 103.9},
+		{Latitude: 1.6, Longitude: 103.94},
+		{Latitude: 1.6, Longitude: 104.2},
+		{Latitude: 1, Longitude: 104.2},
+	},
+	RegionNorth: {
+		{Latitude: 1.4, Longitude: 103.76},
+		{Latitude: 1.6, Longitude: 103.72},
+		{Latitude: 1.6, Longitude: 103.94},
+		{Latitude: 1.4, Longitude: 103.9},
+	},
+	RegionSouth: {
+		{Latitude: 1.305, Longitude: 103.76},
+		{Latitude: 1.305, Longitude: 103.885},
+		{Latitude: 1, Longitude: 103.885},
+		{Latitude: 1, Longitude: 103.76},
+	},
+	RegionWest: {
+		{Latitude: 1, Longitude: 103.5},
+		{Latitude: 1.6, Longitude: 103.5},
+		{Latitude: 1.6, Longitude: 103.72},
+		{Latitude: 1.4, Longitude: 103.76},
+		{Latitude: 1, Longitude: 103.76},
+	},
+}
+
+// regionIslands are the outlying islands of each region.
+var regionIslands = map[string][]geo.Ring{
+	RegionEast:  {pulauUbinBoundary, pulauTekongBoundary},
+	RegionSouth: {sentosaBoundary, southernIslandsBoundary},
+	RegionWest:  {westernIslandsBoundary},
+}
+
+// regionBoundaries are the simplified boundaries of the regions used by
+// the PSI and PM2.5 readings, which partition singaporeBoundary. Each is
+// made up of the part of the main island within its partition, followed
+// by its outlying islands.
+var regionBoundaries = newRegionBoundaries()
+
+// newRegionBoundaries returns the boundaries of the regions.
+func newRegionBoundaries() map[string]geo.MultiPolygon {
+	boundaries := make(map[string]geo.MultiPolygon, len(regions))
+	for _, region := range regions {
+		boundary := geo.MultiPolygon{{mainlandBoundary.Clip(regionPartitions[region])}}
+		for _, island := range regionIslands[region] {
+			boundary = append(boundary, geo.Polygon{island})
+		}
+		boundaries[region] = boundary
+	}
+	return boundaries
+}
+
+// RegionAt returns the key of the PSI and PM2.5 region containing the
+// given coordinates.
+func RegionAt(latitude, longitude float64) (string, bool) {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	for _, region := range regions {
+		if regionBoundaries[region].Contains(p) {
+			return region, true
+		}
+	}
+	return "", false
+}
+
+// RegionBoundary returns the simplified boundary of a PSI and PM2.5 region.
+func RegionBoundary(region string) (geo.MultiPolygon, bool) {
+	boundary, ok := regionBoundaries[region]
+	if !ok {
+		return nil, false
+	}
+	m := make(geo.MultiPolygon, len(boundary))
+	for i, polygon := range boundary {
+		m[i] = make(geo.Polygon, len(polygon))
+		for j, ring := range polygon {
+			m[i][j] = append(geo.Ring(nil), ring...)
+		}
+	}
+	return m, true
+}
+
+// regionAt returns the key of the region containing the given coordinates
+// or an error if there is none.
+func regionAt(latitude, longitude float64) (string, error) {
+	region, ok := RegionAt(latitude, longitude)
+	if !ok {
+		return "", fmt.Errorf("%w: (%v, %v)", ErrOutsideRegions, latitude, longitude)
+	}
+	return region, nil
+}
+
+// PSIRegionReadings represents the PSI and its sub-component readings of
+// a single region.
+type PSIRegionReadings struct {
+	// Key of the region
+	Region string
+
+	// PSI overall reading
+	PSITwentyFourHourly int
+
+	// PM10 readings
+	PM10SubIndex         int
+	PM10TwentyFourHourly int
+
+	// PM2.5 readings
+	PM25SubIndex         int
+	PM25TwentyFourHourly int
+
+	// Ozone readings
+	O3SubIndex     int
+	O3EightHourMax int
+
+	// Carbon Monoxide readings
+	COSubIndex     int
+	COEightHourMax float64
+
+	// Sulphur Dioxide readings
+	SO2SubIndex         int
+	SO2TwentyFourHourly int
+
+	// Nitrogen Dioxide readings
+	NO2OneHourMax int
+}
+
+// Region returns the readings of a single region.
+func (r *PSIItemReadings) Region(region string) PSIRegionReadings {
+	return PSIRegionReadings{
+		Region:               region,
+		PSITwentyFourHourly:  r.PSITwentyFourHourly[region],
+		PM10SubIndex:         r.PM10SubIndex[region],
+		PM10TwentyFourHourly: r.PM10TwentyFourHourly[region],
+		PM25SubIndex:         r.PM25SubIndex[region],
+		PM25TwentyFourHourly: r.PM25TwentyFourHourly[region],
+		O3SubIndex:           r.O3SubIndex[region],
+		O3EightHourMax:       r.O3EightHourMax[region],
+		COSubIndex:           r.COSubIndex[region],
+		COEightHourMax:       r.COEightHourMax[region],
+		SO2SubIndex:          r.SO2SubIndex[region],
+		SO2TwentyFourHourly:  r.SO2TwentyFourHourly[region],
+		NO2OneHourMax:        r.NO2OneHourMax[region],
+	}
+}
+
+// ReadingsAt returns the latest PSI readings of the region containing the
+// given coordinates.
+func (p *PSI) ReadingsAt(latitude, longitude float64) (*PSIRegionReadings, error) {
+	if len(p.Items) == 0 {
+		return nil, ErrNoReadings
+	}
+	region, err := regionAt(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+	readings := p.Items[len(p.Items)-1].Readings.Region(region)
+	return &readings, nil
+}
+
+// ReadingAt returns the latest one-hourly PM2.5 reading of the region
+// containing the given coordinates, along with the key of the region.
+func (p *PM25) ReadingAt(latitude, longitude float64) (string, int, error) {
+	if len(p.Items) == 0 {
+		return "", 0, ErrNoReadings
+	}
+	region, err := regionAt(latitude, longitude)
+	if err != nil {
+		return "", 0, err
+	}
+	return region, p.Items[len(p.Items)-1].Readings.PM25OneHourly[region], nil
+}
@@ -0,0 +1,181 @@
+package datagovsg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+func TestRegionAt(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      string
+		ok        bool
+	}{
+		{"jurong_east", 1.3329, 103.7436, RegionWest, true},
+		{"tuas", 1.2950, 103.6360, RegionWest, true},
+		{"woodlands", 1.4382, 103.7890, RegionNorth, true},
+		{"yishun", 1.4304, 103.8354, RegionNorth, true},
+		{"bishan", 1.3526, 103.8352, RegionCentral, true},
+		{"bukit_timah", 1.3294, 103.8021, RegionCentral, true},
+		{"tampines", 1.3496, 103.9568, RegionEast, true},
+		{"changi_airport", 1.3644, 103.9915, RegionEast, true},
+		{"raffles_place", 1.2840, 103.8514, RegionSouth, true},
+		{"sentosa", 1.2494, 103.8303, RegionSouth, true},
+		{"st_johns_island", 1.2200, 103.8500, RegionSouth, true},
+		{"lim_chu_kang", 1.4380, 103.7050, RegionWest, true},
+		{"tuas_checkpoint", 1.3480, 103.6365, RegionWest, true},
+		{"woodlands_waterfront", 1.4535, 103.7800, RegionNorth, true},
+		{"sembawang_park", 1.4600, 103.8365, RegionNorth, true},
+		{"punggol_point", 1.4180, 103.9100, RegionEast, true},
+		{"pasir_ris_park", 1.3815, 103.9510, RegionEast, true},
+		{"changi_village", 1.3890, 103.9880, RegionEast, true},
+		{"east_coast_park", 1.3008, 103.9120, RegionEast, true},
+		{"labrador_park", 1.2660, 103.8020, RegionSouth, true},
+		{"pulau_semakau", 1.2000, 103.7600, RegionWest, true},
+		{"pulau_ubin", 1.4100, 103.9600, RegionEast, true},
+		{"pulau_tekong", 1.4100, 104.0500, RegionEast, true},
+		{"johor_bahru", 1.4927, 103.7414, "", false},
+		{"batam", 1.1301, 104.0529, "", false},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := RegionAt(tc.latitude, tc.longitude)
+			if got != tc.want || ok != tc.ok {
+				t.Errorf("got (%v, %v) want (%v, %v)", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestRegionAt_LabelLocations(t *testing.T) {
+	// Assert the label location of every region lies within its boundary
+	psi := &PSI{}
+	loadFixture(t, "testdata/fixtures/environment_psi_default.json", psi)
+	for _, p := range psi.Places() {
+		if got, _ := RegionAt(p.Latitude, p.Longitude); got != p.ID {
+			t.Errorf("got region %v for label location of %v", got, p.ID)
+		}
+	}
+}
+
+func TestRegionAt_ForecastAreas(t *testing.T) {
+	// Assert the label location of every forecast area lies within a region
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	for _, p := range f.Places() {
+		if _, ok := RegionAt(p.Latitude, p.Longitude); !ok {
+			t.Errorf("expected region for label location of %v", p.Name)
+		}
+	}
+}
+
+func TestRegionAt_Partition(t *testing.T) {
+	// Assert every point within Singapore lies within exactly one region
+	b := singaporeBoundary.Bounds()
+	for lat := b.Min.Latitude; lat <= b.Max.Latitude; lat += 0.002 {
+		for lon := b.Min.Longitude; lon <= b.Max.Longitude; lon += 0.002 {
+			p := geo.Point{Latitude: lat, Longitude: lon}
+			var found []string
+			for _, region := range regions {
+				if regionBoundaries[region].Contains(p) {
+					found = append(found, region)
+				}
+			}
+			if want := singaporeBoundary.Contains(p); want != (len(found) > 0) || len(found) > 1 {
+				t.Errorf("got regions %v for %+v within Singapore: %v", found, p, want)
+			}
+		}
+	}
+}
+
+func TestRegionBoundary(t *testing.T) {
+	// Assert boundaries are copies
+	boundary, ok := RegionBoundary(RegionSouth)
+	if !ok || len(boundary) != 3 {
+		t.Fatalf("got %+v want %v polygons", boundary, 3)
+	}
+	for _, polygon := range boundary {
+		for _, ring := range polygon {
+			ring[0].Latitude = 0
+		}
+	}
+	got, _ := RegionBoundary(RegionSouth)
+	for _, polygon := range got {
+		for _, ring := range polygon {
+			if ring[0].Latitude == 0 {
+				t.Errorf("expected boundary to be unchanged")
+			}
+		}
+	}
+
+	if _, ok := RegionBoundary("atlantis"); ok {
+		t.Errorf("expected no boundary for unknown region")
+	}
+}
+
+func TestPSI_ReadingsAt(t *testing.T) {
+	psi := &PSI{}
+	loadFixture(t, "testdata/fixtures/environment_psi_default.json", psi)
+
+	// Assert readings of the east region
+	got, err := psi.ReadingsAt(1.3496, 103.9568)
+	if err != nil {
+		t.Fatalf("expected no errors but got: %v", err)
+	}
+	if got.Region != RegionEast || got.PSITwentyFourHourly != 43 || got.COEightHourMax != 0.35 {
+		t.Errorf("got %+v", got)
+	}
+
+	// Assert errors
+	if _, err := psi.ReadingsAt(1.4927, 103.7414); !errors.Is(err, ErrOutsideRegions) {
+		t.Errorf("expected error '%v' but got: %v", ErrOutsideRegions, err)
+	}
+	if _, err := (&PSI{}).ReadingsAt(1.3496, 103.9568); !errors.Is(err, ErrNoReadings) {
+		t.Errorf("expected error '%v' but got: %v", ErrNoReadings, err)
+	}
+}
+
+func TestPM25_ReadingAt(t *testing.T) {
+	pm25 := &PM25{}
+	loadFixture(t, "testdata/fixtures/environment_pm25_default.json", pm25)
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		region    string
+		want      int
+		err       error
+	}{
+		{"west", 1.3329, 103.7436, RegionWest, 14, nil},
+		{"north", 1.4382, 103.7890, RegionNorth, 11, nil},
+		{"outside", 1.1301, 104.0529, "", 0, ErrOutsideRegions},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			region, got, err := pm25.ReadingAt(tc.latitude, tc.longitude)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected error '%v' but got: %v", tc.err, err)
+			}
+			if region != tc.region || got != tc.want {
+				t.Errorf("got (%v, %v) want (%v, %v)", region, got, tc.region, tc.want)
+			}
+		})
+	}
+}
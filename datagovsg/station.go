@@ -0,0 +1,279 @@
+package datagovsg
+
+import (
+	"sort"
+	"strings"
+)
+
+// Measurement represents a type of reading made by a weather station.
+type Measurement string
+
+const (
+	// MeasurementAirTemperature represents air temperature readings.
+	MeasurementAirTemperature Measurement = "air_temperature"
+
+	// MeasurementRainfall represents rainfall readings.
+	MeasurementRainfall Measurement = "rainfall"
+
+	// MeasurementRelativeHumidity represents relative humidity readings.
+	MeasurementRelativeHumidity Measurement = "relative_humidity"
+
+	// MeasurementWindDirection represents wind direction readings.
+	MeasurementWindDirection Measurement = "wind_direction"
+
+	// MeasurementWindSpeed represents wind speed readings.
+	MeasurementWindSpeed Measurement = "wind_speed"
+
+	// MeasurementWBGT represents Wet Bulb Globe Temperature readings.
+	MeasurementWBGT Measurement = "wbgt"
+)
+
+// Station represents a weather station and the measurements it provides.
+type Station struct {
+	// ID of the station
+	ID string
+
+	// ID of the device (usually the same as the station)
+	DeviceID string
+
+	// Name of the station
+	Name string
+
+	// Latitude of the station
+	Latitude float64
+
+	// Longitude of the station
+	Longitude float64
+
+	// Measurements provided by the station
+	Measurements []Measurement
+}
+
+// StationMetadata represents metadata information specific to a weather
+// station, in the shape shared by the weather observation resources.
+type StationMetadata struct {
+	// ID of a station
+	ID string `json:"id"`
+
+	// ID of the device (usually the same as the station)
+	DeviceID string `json:"device_id"`
+
+	// Name of the station
+	Name string `json:"name"`
+
+	// Location of the station
+	Location StationMetadataLocation `json:"location"`
+}
+
+// StationMetadataLocation represents the geographical coordindates of a
+// weather station.
+type StationMetadataLocation struct {
+	// Longitude of the station
+	Longitude float64 `json:"longitude"`
+
+	// Latitude of the station
+	Latitude float64 `json:"latitude"`
+}
+
+// StationItem represents the readings of the weather stations at a point
+// in time, in the shape shared by the weather observation resources.
+type StationItem struct {
+	// Timestamp of the reading
+	Timestamp string `json:"timestamp"`
+
+	// Data readings
+	Readings []StationItemReading `json:"readings"`
+}
+
+// StationItemReading represents a single reading at a specific station at
+// a point in time.
+type StationItemReading struct {
+	// ID of the station
+	StationID string `json:"station_id"`
+
+	// Value of the reading
+	Value float64 `json:"value"`
+}
+
+// stationsOf returns the stations of the metadata, each providing the
+// given measurement.
+func stationsOf(metadata []StationMetadata, m Measurement) []Station {
+	stations := make([]Station, 0, len(metadata))
+	for _, s := range metadata {
+		stations = append(stations, Station{
+			ID:           s.ID,
+			DeviceID:     s.DeviceID,
+			Name:         s.Name,
+			Latitude:     s.Location.Latitude,
+			Longitude:    s.Location.Longitude,
+			Measurements: []Measurement{m},
+		})
+	}
+	return stations
+}
+
+// stationReadingsOf returns the readings of every item as readings of the
+// given measurement and unit.
+func stationReadingsOf(items []StationItem, m Measurement, unit string) []StationReading {
+	var readings []StationReading
+	for _, item := range items {
+		for _, r := range item.Readings {
+			readings = append(readings, StationReading{
+				StationID:   r.StationID,
+				Measurement: m,
+				Timestamp:   item.Timestamp,
+				Value:       r.Value,
+				Unit:        unit,
+			})
+		}
+	}
+	return readings
+}
+
+// latestReadings returns the readings sharing the timestamp of the last
+// reading, which are those of the latest item.
+func latestReadings(readings []StationReading) []StationReading {
+	i := len(readings)
+	for i > 0 && readings[i-1].Timestamp == readings[len(readings)-1].Timestamp {
+		i--
+	}
+	return readings[i:]
+}
+
+// Provides returns true if the station provides the measurement.
+func (s Station) Provides(m Measurement) bool {
+	for _, measurement := range s.Measurements {
+		if measurement == m {
+			return true
+		}
+	}
+	return false
+}
+
+// StationLister is implemented by resources containing weather station
+// metadata.
+type StationLister interface {
+	Stations() []Station
+}
+
+// StationReading represents a reading made by a weather station.
+type StationReading struct {
+	// ID of the station
+	StationID string
+
+	// Type of the reading
+	Measurement Measurement
+
+	// Timestamp of the reading
+	Timestamp string
+
+	// Value of the reading
+	Value float64
+
+	// Measurement unit of the reading, if known
+	Unit string
+}
+
+// StationReadingLister is implemented by resources containing weather
+// station metadata and readings.
+type StationReadingLister interface {
+	StationLister
+	StationReadings() []StationReading
+}
+
+// StationCatalog is a catalogue of weather stations merged from the
+// metadata of one or more resources.
+type StationCatalog struct {
+	stations   map[string]*Station
+	byName     map[string]*Station
+	byDeviceID map[string]*Station
+}
+
+// NewStationCatalog returns a new StationCatalog containing the stations
+// of the given resources.
+func NewStationCatalog(resources ...StationLister) *StationCatalog {
+	c := &StationCatalog{
+		stations:   make(map[string]*Station),
+		byName:     make(map[string]*Station),
+		byDeviceID: make(map[string]*Station),
+	}
+	for _, r := range resources {
+		c.Add(r.Stations()...)
+	}
+	return c
+}
+
+// Add merges the stations into the catalogue. Stations that are already
+// in the catalogue retain their metadata and gain any new measurements.
+func (c *StationCatalog) Add(stations ...Station) {
+	for _, station := range stations {
+		s, ok := c.stations[station.ID]
+		if !ok {
+			s = &Station{
+				ID:        station.ID,
+				DeviceID:  station.DeviceID,
+				Name:      station.Name,
+				Latitude:  station.Latitude,
+				Longitude: station.Longitude,
+			}
+			c.stations[s.ID] = s
+			c.byName[strings.ToLower(s.Name)] = s
+			c.byDeviceID[s.DeviceID] = s
+		}
+		for _, m := range station.Measurements {
+			if !s.Provides(m) {
+				s.Measurements = append(s.Measurements, m)
+			}
+		}
+	}
+}
+
+// Len returns the number of stations in the catalogue.
+func (c *StationCatalog) Len() int {
+	return len(c.stations)
+}
+
+// Station returns the station with the given ID.
+func (c *StationCatalog) Station(id string) (Station, bool) {
+	return c.lookup(c.stations[id])
+}
+
+// StationByName returns the station with the given name, ignoring case.
+func (c *StationCatalog) StationByName(name string) (Station, bool) {
+	return c.lookup(c.byName[strings.ToLower(name)])
+}
+
+// StationByDeviceID returns the station with the given device ID.
+func (c *StationCatalog) StationByDeviceID(deviceID string) (Station, bool) {
+	return c.lookup(c.byDeviceID[deviceID])
+}
+
+// lookup returns a copy of the station if it exists.
+func (c *StationCatalog) lookup(s *Station) (Station, bool) {
+	if s == nil {
+		return Station{}, false
+	}
+	station := *s
+	station.Measurements = append([]Measurement(nil), s.Measurements...)
+	return station, true
+}
+
+// Stations returns all stations in the catalogue sorted by ID. If any
+// measurements are given, only stations providing all of them are returned.
+func (c *StationCatalog) Stations(measurements ...Measurement) []Station {
+	var stations []Station
+next:
+	for _, s := range c.stations {
+		for _, m := range measurements {
+			if !s.Provides(m) {
+				continue next
+			}
+		}
+		station, _ := c.lookup(s)
+		stations = append(stations, station)
+	}
+	sort.Slice(stations, func(i, j int) bool {
+		return stations[i].ID < stations[j].ID
+	})
+	return stations
+}
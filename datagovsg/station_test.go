@@ -0,0 +1,191 @@
+package datagovsg
+
+import (
+	"reflect"
+	"testing"
+)
+
+// loadStationCatalog returns a StationCatalog built from the fixtures of
+// all station-based resources.
+func loadStationCatalog(t *testing.T) *StationCatalog {
+	t.Helper()
+	at := &AirTemperature{}
+	loadFixture(t, "testdata/fixtures/environment_airtemperature_default.json", at)
+	rf := &Rainfall{}
+	loadFixture(t, "testdata/fixtures/environment_rainfall_default.json", rf)
+	rh := &RelativeHumidity{}
+	loadFixture(t, "testdata/fixtures/environment_relativehumidity_default.json", rh)
+	wd := &WindDirection{}
+	loadFixture(t, "testdata/fixtures/environment_winddirection_default.json", wd)
+	ws := &WindSpeed{}
+	loadFixture(t, "testdata/fixtures/environment_windspeed_default.json", ws)
+	return NewStationCatalog(at, rf, rh, wd, ws)
+}
+
+func TestStationCatalog(t *testing.T) {
+	c := loadStationCatalog(t)
+	if got, want := c.Len(), 51; got != want {
+		t.Errorf("got %v stations want %v", got, want)
+	}
+
+	// Create test cases
+	all := []Measurement{MeasurementAirTemperature, MeasurementRainfall, MeasurementRelativeHumidity, MeasurementWindDirection, MeasurementWindSpeed}
+	cases := []struct {
+		name   string
+		lookup func() (Station, bool)
+		want   Station
+		ok     bool
+	}{
+		{
+			"id",
+			func() (Station, bool) { return c.Station("S24") },
+			Station{"S24", "S24", "Upper Changi Road North", 1.3678, 103.9826, all},
+			true,
+		},
+		{
+			"name",
+			func() (Station, bool) { return c.StationByName("admiralty road west") },
+			Station{"S105", "S105", "Admiralty Road West", 1.45817, 103.79525, []Measurement{MeasurementRainfall}},
+			true,
+		},
+		{
+			"device_id",
+			func() (Station, bool) { return c.StationByDeviceID("S111") },
+			Station{"S111", "S111", "Scotts Road", 1.31055, 103.8365, []Measurement{MeasurementAirTemperature, MeasurementRainfall, MeasurementRelativeHumidity}},
+			true,
+		},
+		{
+			"not_found",
+			func() (Station, bool) { return c.Station("S0") },
+			Station{},
+			false,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tc.lookup()
+			if ok != tc.ok {
+				t.Errorf("expected found %v but got: %v", tc.ok, ok)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStationCatalog_Stations(t *testing.T) {
+	c := loadStationCatalog(t)
+
+	// Create test cases
+	cases := []struct {
+		name         string
+		measurements []Measurement
+		want         int
+	}{
+		{"all", nil, 51},
+		{"rainfall", []Measurement{MeasurementRainfall}, 51},
+		{"wind", []Measurement{MeasurementWindDirection, MeasurementWindSpeed}, 15},
+		{"wbgt", []Measurement{MeasurementWBGT}, 0},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := c.Stations(tc.measurements...)
+			if len(got) != tc.want {
+				t.Errorf("got %v stations want %v", len(got), tc.want)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i-1].ID >= got[i].ID {
+					t.Errorf("stations not sorted by id: %v before %v", got[i-1].ID, got[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestStationCatalog_Add(t *testing.T) {
+	c := NewStationCatalog()
+	c.Add(Station{ID: "S50", Name: "Clementi Road", Measurements: []Measurement{MeasurementAirTemperature}})
+	c.Add(Station{ID: "S50", Name: "Renamed", Measurements: []Measurement{MeasurementAirTemperature, MeasurementWBGT}})
+
+	// Assert metadata is retained and measurements are merged
+	got, _ := c.Station("S50")
+	want := Station{ID: "S50", Name: "Clementi Road", Measurements: []Measurement{MeasurementAirTemperature, MeasurementWBGT}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	// Assert returned stations are copies
+	got.Measurements[0] = MeasurementRainfall
+	if s, _ := c.Station("S50"); s.Provides(MeasurementRainfall) {
+		t.Errorf("expected catalogue to be unmodified but got: %+v", s)
+	}
+}
+
+func TestStationReadingLister(t *testing.T) {
+	var rainfall Rainfall
+	loadFixture(t, "testdata/fixtures/environment_rainfall_default.json", &rainfall)
+	var wbgt WBGT
+	loadFixture(t, "testdata/fixtures/environment_wbgt_default.json", &wbgt)
+
+	// Create test cases
+	cases := []struct {
+		name     string
+		resource StationReadingLister
+		want     StationReading
+	}{
+		{"rainfall", &rainfall, StationReading{"S105", MeasurementRainfall, "2020-01-01T00:00:00+08:00", 0, "mm"}},
+		{"wbgt", &wbgt, StationReading{"S128", MeasurementWBGT, "2024-07-16T14:00:00+08:00", 30.2, ""}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			readings := tc.resource.StationReadings()
+			if len(readings) == 0 {
+				t.Fatalf("got no readings")
+			}
+			if got := readings[0]; got != tc.want {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLatestReadings(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name     string
+		readings []StationReading
+		want     []StationReading
+	}{
+		{"empty", nil, nil},
+		{"single_item", []StationReading{{StationID: "S1", Timestamp: "t1"}, {StationID: "S2", Timestamp: "t1"}}, []StationReading{{StationID: "S1", Timestamp: "t1"}, {StationID: "S2", Timestamp: "t1"}}},
+		{"latest_item", []StationReading{{StationID: "S1", Timestamp: "t1"}, {StationID: "S1", Timestamp: "t2"}, {StationID: "S2", Timestamp: "t2"}}, []StationReading{{StationID: "S1", Timestamp: "t2"}, {StationID: "S2", Timestamp: "t2"}}},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := latestReadings(tc.readings); len(got) != len(tc.want) || len(got) > 0 && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package datagovsg
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+const (
+	// The default maximum age of a CarparkDirectory.
+	carparkDirectoryTTL = 24 * time.Hour
+
+	// The number of records fetched per page when refreshing a CarparkDirectory.
+	carparkDirectoryPageSize = 1000
+)
+
+// CarparkInformation represents the reference information of a HDB carpark
+// with its location converted to WGS84 coordinates.
+type CarparkInformation struct {
+	HDBCarparkInformationRecord
+
+	// Latitude of the carpark
+	Latitude float64
+
+	// Longitude of the carpark
+	Longitude float64
+}
+
+// EnrichedCarpark represents the lot availability of a carpark joined
+// with its reference information.
+type EnrichedCarpark struct {
+	CarparkAvailabilityCarpark
+
+	// Reference information of the carpark, or nil if the carpark is not
+	// found in the HDB carpark information dataset
+	Information *CarparkInformation
+}
+
+// CarparkDirectory is a locally cached directory of HDB carpark information
+// keyed by carpark number. It is safe for concurrent use.
+type CarparkDirectory struct {
+	// Client used to fetch the HDB carpark information dataset
+	Client *Client
+
+	// Maximum age of the cached information before it is refreshed
+	TTL time.Duration
+
+	mu        sync.RWMutex
+	carparks  map[string]*CarparkInformation
+	updatedAt time.Time
+}
+
+// NewCarparkDirectory returns a new CarparkDirectory object.
+func NewCarparkDirectory(c *Client) *CarparkDirectory {
+	return &CarparkDirectory{
+		Client: c,
+		TTL:    carparkDirectoryTTL,
+	}
+}
+
+// Refresh fetches the entire HDB carpark information dataset and replaces
+// the cached information.
+func (d *CarparkDirectory) Refresh() error {
+	carparks := make(map[string]*CarparkInformation)
+	for offset := 0; ; offset += carparkDirectoryPageSize {
+		data, err := d.Client.GetHDBCarparkInformation(
+			&QueryOption{Key: "limit", Value: strconv.Itoa(carparkDirectoryPageSize)},
+			&QueryOption{Key: "offset", Value: strconv.Itoa(offset)},
+		)
+		if err != nil {
+			return err
+		}
+		for _, record := range data.Result.Records {
+			lat, lon := geo.SVY21ToWGS84(record.XCoord, record.YCoord)
+			carparks[record.CarparkNumber] = &CarparkInformation{
+				HDBCarparkInformationRecord: record,
+				Latitude:                    lat,
+				Longitude:                   lon,
+			}
+		}
+		if len(data.Result.Records) < carparkDirectoryPageSize || offset+carparkDirectoryPageSize >= data.Result.Total {
+			break
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.carparks = carparks
+	d.updatedAt = time.Now()
+	return nil
+}
+
+// UpdatedAt returns the time at which the directory was last refreshed.
+func (d *CarparkDirectory) UpdatedAt() time.Time {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.updatedAt
+}
+
+// ensure refreshes the directory if it is empty or older than its TTL.
+func (d *CarparkDirectory) ensure() error {
+	d.mu.RLock()
+	stale := d.carparks == nil || (d.TTL > 0 && time.Since(d.updatedAt) > d.TTL)
+	d.mu.RUnlock()
+	if stale {
+		return d.Refresh()
+	}
+	return nil
+}
+
+// Lookup returns the information of a carpark by its carpark number, or
+// nil if the carpark is not found.
+func (d *CarparkDirectory) Lookup(carparkNumber string) (*CarparkInformation, error) {
+	if err := d.ensure(); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.carparks[carparkNumber], nil
+}
+
+// Enrich joins the carparks in the carpark availability with their
+// reference information.
+func (d *CarparkDirectory) Enrich(a *CarparkAvailability) ([]EnrichedCarpark, error) {
+	if err := d.ensure(); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var carparks []EnrichedCarpark
+	for _, item := range a.Items {
+		for _, carpark := range item.CarparkData {
+			carparks = append(carparks, EnrichedCarpark{
+				CarparkAvailabilityCarpark: carpark,
+				Information:                d.carparks[carpark.CarparkNumber],
+			})
+		}
+	}
+	return carparks, nil
+}
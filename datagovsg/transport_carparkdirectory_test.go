@@ -0,0 +1,125 @@
+package datagovsg
+
+import (
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCarparkDirectoryServer returns a mock server serving the HDB carpark
+// information fixture and counting the number of requests.
+func newCarparkDirectoryServer(t *testing.T, requests *int32) *httptest.Server {
+	b, err := ioutil.ReadFile("testdata/fixtures/transport_hdbcarparkinformation_default.json")
+	if err != nil {
+		t.Fatalf("error loading test fixtures: %v", err)
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.WriteHeader(http.StatusOK)
+		w.Write(b)
+	})
+	return httptest.NewServer(handler)
+}
+
+func TestCarparkDirectory_Enrich(t *testing.T) {
+	// Mock HTTP server
+	var requests int32
+	server := newCarparkDirectoryServer(t, &requests)
+	defer server.Close()
+	client := NewClient()
+	client.DatastoreBaseURL = server.URL
+
+	// Enrich carpark availability
+	d := NewCarparkDirectory(client)
+	a := &CarparkAvailability{
+		Items: []CarparkAvailabilityItem{
+			{
+				CarparkData: []CarparkAvailabilityCarpark{
+					{CarparkNumber: "ACB"},
+					{CarparkNumber: "XYZ"},
+					{CarparkNumber: "ACM"},
+				},
+			},
+		},
+	}
+	got, err := d.Enrich(a)
+	if err != nil {
+		t.Fatalf("expected no errors but got: %v", err)
+	}
+
+	// Assert enriched carparks
+	if len(got) != 3 {
+		t.Fatalf("got %v carparks want %v", len(got), 3)
+	}
+	if got[1].Information != nil {
+		t.Errorf("expected no information for unknown carpark but got: %+v", got[1].Information)
+	}
+	acb := got[0].Information
+	if acb == nil || acb.Address != "BLK 270/271 ALBERT CENTRE BASEMENT CAR PARK" || acb.GantryHeight != 1.8 {
+		t.Fatalf("got %+v for carpark ACB", acb)
+	}
+	if math.Abs(acb.Latitude-1.30106) > 1e-4 || math.Abs(acb.Longitude-103.85412) > 1e-4 {
+		t.Errorf("got location (%v, %v) want (%v, %v)", acb.Latitude, acb.Longitude, 1.30106, 103.85412)
+	}
+	if got[2].Information == nil || got[2].Information.FreeParking != "SUN & PH FR 7AM-10.30PM" {
+		t.Errorf("got %+v for carpark ACM", got[2].Information)
+	}
+
+	// Assert cached information is reused
+	if _, err := d.Lookup("ACB"); err != nil {
+		t.Errorf("expected no errors but got: %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("got %v requests want %v", n, 1)
+	}
+}
+
+func TestCarparkDirectory_Lookup(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name     string
+		ttl      time.Duration
+		lookups  int
+		requests int32
+	}{
+		{"cached", time.Hour, 3, 1},
+		{"expired", time.Nanosecond, 3, 3},
+		{"no_expiry", 0, 3, 1},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock HTTP server
+			var requests int32
+			server := newCarparkDirectoryServer(t, &requests)
+			defer server.Close()
+			client := NewClient()
+			client.DatastoreBaseURL = server.URL
+
+			// Lookup carparks
+			d := NewCarparkDirectory(client)
+			d.TTL = tc.ttl
+			for i := 0; i < tc.lookups; i++ {
+				time.Sleep(time.Millisecond)
+				info, err := d.Lookup("ACM")
+				if err != nil {
+					t.Fatalf("expected no errors but got: %v", err)
+				}
+				if info == nil || info.CarparkDecks != 5 {
+					t.Errorf("got %+v for carpark ACM", info)
+				}
+			}
+			if n := atomic.LoadInt32(&requests); n != tc.requests {
+				t.Errorf("got %v requests want %v", n, tc.requests)
+			}
+		})
+	}
+}
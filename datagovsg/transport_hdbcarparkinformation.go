@@ -0,0 +1,127 @@
+package datagovsg
+
+import "encoding/json"
+
+// HDBCarparkInformation is the resource representing the information of
+// HDB carparks.
+type HDBCarparkInformation struct {
+	Help    string                      `json:"help"`
+	Success bool                        `json:"success"`
+	Result  HDBCarparkInformationResult `json:"result"`
+}
+
+// HDBCarparkInformationResult represents a page of HDB carpark information
+// records.
+type HDBCarparkInformationResult struct {
+	// ID of the resource
+	ResourceID string `json:"resource_id"`
+
+	// Fields of the records
+	Fields []DatastoreSearchField `json:"fields"`
+
+	// HDB carpark information records
+	Records []HDBCarparkInformationRecord `json:"records"`
+
+	// Links to the current and next pages
+	Links DatastoreSearchLinks `json:"_links"`
+
+	// Maximum number of records returned
+	Limit int `json:"limit"`
+
+	// Total number of records matching the search
+	Total int `json:"total"`
+}
+
+// HDBCarparkInformationRecord represents the information of a single HDB
+// carpark.
+type HDBCarparkInformationRecord struct {
+	// Identifier string of the carpark
+	CarparkNumber string
+
+	// Address of the carpark
+	Address string
+
+	// SVY21 easting of the carpark
+	XCoord float64
+
+	// SVY21 northing of the carpark
+	YCoord float64
+
+	// Type of the carpark, e.g. "MULTI-STOREY CAR PARK"
+	CarparkType string
+
+	// Type of parking system, e.g. "ELECTRONIC PARKING"
+	ParkingSystem string
+
+	// Hours when short-term parking is allowed
+	ShortTermParking string
+
+	// Hours when free parking is allowed, or "NO"
+	FreeParking string
+
+	// Whether night parking is allowed
+	NightParking bool
+
+	// Number of carpark decks
+	CarparkDecks int
+
+	// Gantry height in metres
+	GantryHeight float64
+
+	// Whether the carpark has a basement
+	CarparkBasement bool
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *HDBCarparkInformationRecord) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		CarparkNumber    string `json:"car_park_no"`
+		Address          string `json:"address"`
+		XCoord           string `json:"x_coord"`
+		YCoord           string `json:"y_coord"`
+		CarparkType      string `json:"car_park_type"`
+		ParkingSystem    string `json:"type_of_parking_system"`
+		ShortTermParking string `json:"short_term_parking"`
+		FreeParking      string `json:"free_parking"`
+		NightParking     string `json:"night_parking"`
+		CarparkDecks     string `json:"car_park_decks"`
+		GantryHeight     string `json:"gantry_height"`
+		CarparkBasement  string `json:"car_park_basement"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	p := &datastoreValueParser{}
+	*r = HDBCarparkInformationRecord{
+		CarparkNumber:    raw.CarparkNumber,
+		Address:          raw.Address,
+		XCoord:           p.Float("x_coord", raw.XCoord),
+		YCoord:           p.Float("y_coord", raw.YCoord),
+		CarparkType:      raw.CarparkType,
+		ParkingSystem:    raw.ParkingSystem,
+		ShortTermParking: raw.ShortTermParking,
+		FreeParking:      raw.FreeParking,
+		NightParking:     p.Bool("night_parking", raw.NightParking),
+		CarparkDecks:     p.Int("car_park_decks", raw.CarparkDecks),
+		GantryHeight:     p.Float("gantry_height", raw.GantryHeight),
+		CarparkBasement:  p.Bool("car_park_basement", raw.CarparkBasement),
+	}
+	return p.err
+}
+
+// GetHDBCarparkInformation returns the information of HDB carparks.
+func (c *Client) GetHDBCarparkInformation(options ...*QueryOption) (*HDBCarparkInformation, error) {
+	// Execute request
+	b, err := c.getDatastoreSearch(ResourceIDHDBCarparkInformation, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle response
+	data := &HDBCarparkInformation{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,106 @@
+package datagovsg
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetHDBCarparkInformation(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		fixture string
+	}{
+		{"default", "testdata/fixtures/transport_hdbcarparkinformation_default.json"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Load fixtures
+			f, err := os.Open(tc.fixture)
+			if err != nil {
+				t.Errorf("error loading test fixtures: %v", err)
+			}
+			defer f.Close()
+
+			// Read json
+			b, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Errorf("error reading file: %v", err)
+			}
+
+			// Mock HTTP server
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(b)
+			})
+			server := httptest.NewServer(handler)
+
+			// Execute request
+			client := NewClient()
+			client.DatastoreBaseURL = server.URL
+			got, err := client.GetHDBCarparkInformation()
+			if err != nil {
+				t.Errorf("expected no errors but got: %v", err)
+			}
+
+			// Assert response body
+			want := &HDBCarparkInformation{}
+			if err := json.Unmarshal(b, &want); err != nil {
+				t.Errorf("error unmarshalling fixture: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestHDBCarparkInformationRecord_UnmarshalJSON(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name string
+		data string
+		want HDBCarparkInformationRecord
+		err  bool
+	}{
+		{
+			"default",
+			`{"car_park_no":"ACB","x_coord":"30314.7936","y_coord":"31490.4942","night_parking":"YES","car_park_decks":"1","gantry_height":"1.80","car_park_basement":"Y"}`,
+			HDBCarparkInformationRecord{CarparkNumber: "ACB", XCoord: 30314.7936, YCoord: 31490.4942, NightParking: true, CarparkDecks: 1, GantryHeight: 1.8, CarparkBasement: true},
+			false,
+		},
+		{
+			"invalid_coordinates",
+			`{"car_park_no":"ACB","x_coord":"unknown"}`,
+			HDBCarparkInformationRecord{CarparkNumber: "ACB"},
+			true,
+		},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got HDBCarparkInformationRecord
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if (err != nil) != tc.err {
+				t.Errorf("expected error %v but got: %v", tc.err, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package datagovsg
 import (
 	"encoding/json"
 	"net/url"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
 )
 
 // TaxiAvailability is the resource representing the Taxi Availability in Singapore.
@@ -48,6 +50,38 @@ type TaxiAvailabilityFeatureProperties struct {
 	APIInfo   APIInfo `json:"api_info"`
 }
 
+// Points returns the locations of all available taxis. Coordinates are
+// given by the API as longitude and latitude pairs.
+func (t *TaxiAvailability) Points() []geo.Point {
+	return t.appendPoints(nil)
+}
+
+// appendPoints appends the locations of all available taxis to dst and
+// returns the extended slice.
+func (t *TaxiAvailability) appendPoints(dst []geo.Point) []geo.Point {
+	for i := range t.Features {
+		dst = t.Features[i].appendPoints(dst)
+	}
+	return dst
+}
+
+// Points returns the locations of the available taxis in the feature.
+func (f *TaxiAvailabilityFeature) Points() []geo.Point {
+	return f.appendPoints(nil)
+}
+
+// appendPoints appends the locations of the available taxis in the
+// feature to dst and returns the extended slice.
+func (f *TaxiAvailabilityFeature) appendPoints(dst []geo.Point) []geo.Point {
+	for _, c := range f.Geometry.Coordinates {
+		if len(c) < 2 {
+			continue
+		}
+		dst = append(dst, geo.Point{Latitude: c[1], Longitude: c[0]})
+	}
+	return dst
+}
+
 // GetTaxiAvailability returns the taxi availability and the geographical
 // coordinates of these available taxis in Singapore.
 func (c *Client) GetTaxiAvailability(options ...*QueryOption) (*TaxiAvailability, error) {
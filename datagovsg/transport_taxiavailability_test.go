@@ -8,6 +8,8 @@ import (
 	"os"
 	"reflect"
 	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
 )
 
 func TestClient_GetTaxiAvailability(t *testing.T) {
@@ -64,3 +66,15 @@ func TestClient_GetTaxiAvailability(t *testing.T) {
 		})
 	}
 }
+
+func TestTaxiAvailability_Points(t *testing.T) {
+	var taxis TaxiAvailability
+	loadFixture(t, "testdata/fixtures/transport_taxiavailability_default.json", &taxis)
+	want := []geo.Point{
+		{Latitude: 1.31675, Longitude: 103.6409},
+		{Latitude: 1.37304, Longitude: 104.00568},
+	}
+	if got := taxis.Points(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+package datagovsg
+
+import (
+	"sort"
+	"time"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// TaxiCluster represents a hotspot of available taxis.
+type TaxiCluster struct {
+	// Identifier of the cluster, kept across snapshots by a
+	// TaxiClusterTracker
+	ID int
+
+	// Mean location of the taxis in the cluster
+	Centroid geo.Point
+
+	// Number of taxis in the cluster
+	Count int
+
+	// Convex hull of the taxis in the cluster
+	Hull geo.Ring
+
+	// Number of consecutive snapshots in which the cluster was found
+	Snapshots int
+
+	// Timestamp of the snapshot in which the cluster was first found
+	FirstSeen time.Time
+}
+
+// Clusters returns the hotspots of available taxis in the snapshot using
+// DBSCAN clustering, ordered by decreasing count.
+//
+// A hotspot is formed by taxis with at least minPoints taxis, including
+// themselves, within eps metres, together with the taxis within eps
+// metres of them.
+func (t *TaxiAvailability) Clusters(eps float64, minPoints int) []TaxiCluster {
+	ts := t.timestamp()
+	points := t.Points()
+	groups := geo.DBSCAN(points, eps, minPoints)
+	clusters := make([]TaxiCluster, len(groups))
+	for i, members := range groups {
+		c := TaxiCluster{
+			ID:        i + 1,
+			Count:     len(members),
+			Snapshots: 1,
+			FirstSeen: ts,
+		}
+		ps := make([]geo.Point, len(members))
+		for j, m := range members {
+			ps[j] = points[m]
+			c.Centroid.Latitude += points[m].Latitude / float64(len(members))
+			c.Centroid.Longitude += points[m].Longitude / float64(len(members))
+		}
+		c.Hull = geo.ConvexHull(ps)
+		clusters[i] = c
+	}
+	sortTaxiClusters(clusters)
+	return clusters
+}
+
+// timestamp returns the timestamp of the snapshot, or the zero time if it
+// could not be parsed.
+func (t *TaxiAvailability) timestamp() time.Time {
+	if len(t.Features) == 0 {
+		return time.Time{}
+	}
+	ts, _ := time.Parse(time.RFC3339, t.Features[0].Properties.Timestamp)
+	return ts
+}
+
+// sortTaxiClusters orders clusters by decreasing count and then by ID.
+func sortTaxiClusters(clusters []TaxiCluster) {
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Count != clusters[j].Count {
+			return clusters[i].Count > clusters[j].Count
+		}
+		return clusters[i].ID < clusters[j].ID
+	})
+}
+
+// TaxiClusterTracker tracks hotspots of available taxis across
+// consecutive snapshots.
+//
+// A hotspot in a snapshot continues a hotspot of the previous snapshot if
+// its centroid moved by at most MatchDistance metres, in which case it
+// keeps the ID and FirstSeen of the previous hotspot.
+type TaxiClusterTracker struct {
+	// Maximum distance in metres between taxis in a hotspot
+	Epsilon float64
+
+	// Minimum number of taxis within Epsilon of a taxi at the core of a
+	// hotspot
+	MinPoints int
+
+	// Maximum distance in metres the centroid of a hotspot may move
+	// between snapshots, defaulting to Epsilon if zero
+	MatchDistance float64
+
+	clusters []TaxiCluster
+	nextID   int
+}
+
+// NewTaxiClusterTracker returns a new TaxiClusterTracker.
+func NewTaxiClusterTracker(eps float64, minPoints int) *TaxiClusterTracker {
+	return &TaxiClusterTracker{
+		Epsilon:   eps,
+		MinPoints: minPoints,
+	}
+}
+
+// Update returns the hotspots in the snapshot, matched against the
+// hotspots of the previous snapshot.
+func (tr *TaxiClusterTracker) Update(t *TaxiAvailability) []TaxiCluster {
+	clusters := t.Clusters(tr.Epsilon, tr.MinPoints)
+	maxDistance := tr.MatchDistance
+	if maxDistance == 0 {
+		maxDistance = tr.Epsilon
+	}
+
+	// Match the closest pairs of hotspots first
+	prevCentroids := make([]geo.Point, len(tr.clusters))
+	for i, c := range tr.clusters {
+		prevCentroids[i] = c.Centroid
+	}
+	currCentroids := make([]geo.Point, len(clusters))
+	for i, c := range clusters {
+		currCentroids[i] = c.Centroid
+	}
+	ids := make([]int, len(clusters))
+	for _, p := range matchNearest(prevCentroids, currCentroids, maxDistance) {
+		prev := tr.clusters[p.from]
+		ids[p.to] = prev.ID
+		clusters[p.to].Snapshots = prev.Snapshots + 1
+		clusters[p.to].FirstSeen = prev.FirstSeen
+	}
+
+	// Assign new IDs to new hotspots
+	for i := range clusters {
+		if ids[i] == 0 {
+			tr.nextID++
+			ids[i] = tr.nextID
+		}
+		clusters[i].ID = ids[i]
+	}
+	sortTaxiClusters(clusters)
+	tr.clusters = clusters
+	return append([]TaxiCluster(nil), clusters...)
+}
@@ -0,0 +1,104 @@
+package datagovsg
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// taxiHotspot returns the latitude and longitude pairs of n taxis about
+// 10 metres apart in a row starting at the given location.
+func taxiHotspot(latitude, longitude float64, n int) [][2]float64 {
+	coords := make([][2]float64, n)
+	for i := range coords {
+		coords[i] = [2]float64{latitude, longitude + float64(i)*0.0001}
+	}
+	return coords
+}
+
+// timedTaxiSnapshot returns a TaxiAvailability at the given time with
+// taxis at the given latitude and longitude pairs.
+func timedTaxiSnapshot(ts string, coords ...[][2]float64) *TaxiAvailability {
+	var all [][2]float64
+	for _, c := range coords {
+		all = append(all, c...)
+	}
+	t := taxiSnapshot(all...)
+	t.Features[0].Properties.Timestamp = ts
+	return t
+}
+
+func TestTaxiAvailability_Clusters(t *testing.T) {
+	taxis := timedTaxiSnapshot("2019-12-31T23:59:15+08:00",
+		taxiHotspot(1.30, 103.80, 5),
+		taxiHotspot(1.35, 103.90, 8),
+		[][2]float64{{1.40, 103.70}},
+	)
+	clusters := taxis.Clusters(50, 3)
+	if len(clusters) != 2 {
+		t.Fatalf("got %v clusters want %v", len(clusters), 2)
+	}
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		cluster   TaxiCluster
+		count     int
+		latitude  float64
+		longitude float64
+	}{
+		{"largest", clusters[0], 8, 1.35, 103.90035},
+		{"smallest", clusters[1], 5, 1.30, 103.8002},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := tc.cluster
+			if c.Count != tc.count {
+				t.Errorf("got %v want %v", c.Count, tc.count)
+			}
+			if math.Abs(c.Centroid.Latitude-tc.latitude) > 1e-9 || math.Abs(c.Centroid.Longitude-tc.longitude) > 1e-9 {
+				t.Errorf("got %+v want (%v, %v)", c.Centroid, tc.latitude, tc.longitude)
+			}
+			if len(c.Hull) != 2 {
+				t.Errorf("got hull %+v want the two ends of the row", c.Hull)
+			}
+			if want := time.Date(2019, 12, 31, 15, 59, 15, 0, time.UTC); !c.FirstSeen.Equal(want) {
+				t.Errorf("got %v want %v", c.FirstSeen, want)
+			}
+		})
+	}
+}
+
+func TestTaxiClusterTracker(t *testing.T) {
+	tr := NewTaxiClusterTracker(50, 3)
+
+	// First snapshot has two hotspots
+	first := tr.Update(timedTaxiSnapshot("2019-12-31T23:58:00+08:00",
+		taxiHotspot(1.30, 103.80, 5),
+		taxiHotspot(1.35, 103.90, 4),
+	))
+	if len(first) != 2 || first[0].ID != 1 || first[1].ID != 2 {
+		t.Fatalf("got %+v want clusters 1 and 2", first)
+	}
+
+	// Second snapshot moves the first hotspot slightly, drops the second
+	// and adds a third
+	second := tr.Update(timedTaxiSnapshot("2019-12-31T23:59:00+08:00",
+		taxiHotspot(1.3001, 103.80, 6),
+		taxiHotspot(1.40, 103.70, 3),
+	))
+	if len(second) != 2 {
+		t.Fatalf("got %v clusters want %v", len(second), 2)
+	}
+	if c := second[0]; c.ID != 1 || c.Snapshots != 2 || !c.FirstSeen.Equal(first[0].FirstSeen) {
+		t.Errorf("got %+v want cluster 1 seen in 2 snapshots", c)
+	}
+	if c := second[1]; c.ID != 3 || c.Snapshots != 1 {
+		t.Errorf("got %+v want new cluster 3", c)
+	}
+}
@@ -0,0 +1,266 @@
+package datagovsg
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// TaxiMovement represents an available taxi matched between two
+// snapshots.
+type TaxiMovement struct {
+	// Location in the earlier snapshot
+	From geo.Point
+
+	// Location in the later snapshot
+	To geo.Point
+
+	// Distance travelled in metres
+	Distance float64
+}
+
+// TaxiFlow represents the estimated movements of available taxis between
+// two snapshots.
+type TaxiFlow struct {
+	// Taxis available in both snapshots
+	Movements []TaxiMovement
+
+	// Taxis only available in the later snapshot, i.e. taxis becoming free
+	Appeared []geo.Point
+
+	// Taxis only available in the earlier snapshot, i.e. taxis hired
+	Disappeared []geo.Point
+}
+
+// TaxiFlowMatcher estimates the movements of available taxis between
+// snapshots.
+//
+// As taxis are anonymous, taxis are paired greedily by increasing
+// distance, so that every taxi is paired with its nearest unpaired
+// counterpart. Taxis without a counterpart within MaxDistance are
+// considered to have appeared or disappeared.
+type TaxiFlowMatcher struct {
+	// Maximum distance in metres a taxi may travel between snapshots
+	MaxDistance float64
+
+	from []geo.Point
+	to   []geo.Point
+}
+
+// NewTaxiFlowMatcher returns a new TaxiFlowMatcher.
+func NewTaxiFlowMatcher(maxDistance float64) *TaxiFlowMatcher {
+	return &TaxiFlowMatcher{
+		MaxDistance: maxDistance,
+	}
+}
+
+// Match returns the estimated movements of available taxis from the
+// earlier snapshot to the later snapshot.
+func (m *TaxiFlowMatcher) Match(earlier, later *TaxiAvailability) *TaxiFlow {
+	m.from = earlier.appendPoints(m.from[:0])
+	m.to = later.appendPoints(m.to[:0])
+
+	// Pair the closest taxis first
+	flow := &TaxiFlow{}
+	fromMatched := make([]bool, len(m.from))
+	toMatched := make([]bool, len(m.to))
+	for _, p := range matchNearest(m.from, m.to, m.MaxDistance) {
+		fromMatched[p.from], toMatched[p.to] = true, true
+		flow.Movements = append(flow.Movements, TaxiMovement{m.from[p.from], m.to[p.to], p.distance})
+	}
+	for i, ok := range fromMatched {
+		if !ok {
+			flow.Disappeared = append(flow.Disappeared, m.from[i])
+		}
+	}
+	for j, ok := range toMatched {
+		if !ok {
+			flow.Appeared = append(flow.Appeared, m.to[j])
+		}
+	}
+	return flow
+}
+
+// pointMatch represents a pair of points matched by matchNearest.
+type pointMatch struct {
+	from, to int
+	distance float64
+}
+
+// matchNearest pairs points greedily by increasing distance, so that every
+// point is paired with its nearest unpaired counterpart within maxDistance
+// metres. Pairs are returned in the order they are made, with ties broken
+// by index.
+//
+// Rather than listing every pair within maxDistance, only the nearest
+// unpaired candidate of each point of to is kept in a heap, and it is
+// replaced by querying an index once the candidate is paired elsewhere.
+func matchNearest(from, to []geo.Point, maxDistance float64) []pointMatch {
+	if len(from) == 0 || len(to) == 0 {
+		return nil
+	}
+	ix := geo.NewIndex(from)
+	paired := make([]bool, len(from))
+	queried := make([]int, len(to))
+
+	// candidate returns the nearest unpaired point of from to to[j],
+	// querying twice as many neighbours each time they are all paired
+	candidate := func(j int) (pointMatch, bool) {
+		if queried[j] == 0 {
+			queried[j] = 1
+		}
+		for {
+			neighbors := ix.Nearest(to[j], queried[j])
+			for _, n := range neighbors {
+				if n.Distance > maxDistance {
+					return pointMatch{}, false
+				}
+				if !paired[n.Index] {
+					return pointMatch{n.Index, j, n.Distance}, true
+				}
+			}
+			if len(neighbors) < queried[j] {
+				return pointMatch{}, false
+			}
+			queried[j] *= 2
+		}
+	}
+
+	h := make(pointMatchHeap, 0, len(to))
+	for j := range to {
+		if c, ok := candidate(j); ok {
+			h = append(h, c)
+		}
+	}
+	heap.Init(&h)
+	var matches []pointMatch
+	for len(h) > 0 {
+		m := h[0]
+		if paired[m.from] {
+			// Replace candidates paired elsewhere
+			if c, ok := candidate(m.to); ok {
+				h[0] = c
+				heap.Fix(&h, 0)
+			} else {
+				heap.Pop(&h)
+			}
+			continue
+		}
+		heap.Pop(&h)
+		paired[m.from] = true
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// pointMatchHeap is a min-heap of pairs ordered by distance and index.
+type pointMatchHeap []pointMatch
+
+func (h pointMatchHeap) Len() int { return len(h) }
+func (h pointMatchHeap) Less(i, j int) bool {
+	if h[i].distance != h[j].distance {
+		return h[i].distance < h[j].distance
+	}
+	if h[i].from != h[j].from {
+		return h[i].from < h[j].from
+	}
+	return h[i].to < h[j].to
+}
+func (h pointMatchHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *pointMatchHeap) Push(x interface{}) { *h = append(*h, x.(pointMatch)) }
+func (h *pointMatchHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// ZoneFunc returns the name of the zone containing a point.
+type ZoneFunc func(p geo.Point) (string, bool)
+
+// TilingZones returns a ZoneFunc using the cells of a tiling as zones.
+func TilingZones(t geo.Tiling) ZoneFunc {
+	return func(p geo.Point) (string, bool) {
+		return t.Key(p), true
+	}
+}
+
+// PlanningAreaZones returns a ZoneFunc using the planning areas as zones.
+func (pa *PlanningAreas) PlanningAreaZones() ZoneFunc {
+	return func(p geo.Point) (string, bool) {
+		loc, ok := pa.Locate(p.Latitude, p.Longitude)
+		return loc.PlanningArea, ok
+	}
+}
+
+// TaxiODFlow represents the number of taxis moving from one zone to
+// another.
+type TaxiODFlow struct {
+	// Zone in which the taxis were in the earlier snapshot
+	Origin string
+
+	// Zone in which the taxis were in the later snapshot
+	Destination string
+
+	// Number of taxis
+	Count int
+}
+
+// TaxiFlowSummary represents the movements of available taxis aggregated
+// by zone.
+type TaxiFlowSummary struct {
+	// Origin-destination counts, ordered by decreasing count
+	Flows []TaxiODFlow
+
+	// Number of taxis becoming free in each zone
+	Appeared map[string]int
+
+	// Number of taxis hired in each zone
+	Disappeared map[string]int
+}
+
+// Aggregate returns the movements, appearances and disappearances counted
+// by zone. Taxis outside all zones are not counted.
+func (f *TaxiFlow) Aggregate(zone ZoneFunc) *TaxiFlowSummary {
+	s := &TaxiFlowSummary{
+		Appeared:    make(map[string]int),
+		Disappeared: make(map[string]int),
+	}
+	counts := make(map[[2]string]int)
+	for _, mv := range f.Movements {
+		origin, ok := zone(mv.From)
+		if !ok {
+			continue
+		}
+		destination, ok := zone(mv.To)
+		if !ok {
+			continue
+		}
+		counts[[2]string{origin, destination}]++
+	}
+	for key, count := range counts {
+		s.Flows = append(s.Flows, TaxiODFlow{key[0], key[1], count})
+	}
+	sort.Slice(s.Flows, func(i, j int) bool {
+		a, b := s.Flows[i], s.Flows[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Origin != b.Origin {
+			return a.Origin < b.Origin
+		}
+		return a.Destination < b.Destination
+	})
+	for _, p := range f.Appeared {
+		if z, ok := zone(p); ok {
+			s.Appeared[z]++
+		}
+	}
+	for _, p := range f.Disappeared {
+		if z, ok := zone(p); ok {
+			s.Disappeared[z]++
+		}
+	}
+	return s
+}
@@ -0,0 +1,143 @@
+package datagovsg
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+func TestTaxiFlowMatcher_Match(t *testing.T) {
+	earlier := taxiSnapshot(
+		[2]float64{1.3000, 103.8000}, // moves north by about 110m
+		[2]float64{1.3020, 103.8000}, // stays
+		[2]float64{1.3500, 103.9000}, // hired
+	)
+	later := taxiSnapshot(
+		[2]float64{1.3010, 103.8000},
+		[2]float64{1.3020, 103.8000},
+		[2]float64{1.4000, 103.7000}, // becomes free
+	)
+	flow := NewTaxiFlowMatcher(500).Match(earlier, later)
+
+	want := []TaxiMovement{
+		{geo.Point{Latitude: 1.302, Longitude: 103.8}, geo.Point{Latitude: 1.302, Longitude: 103.8}, 0},
+		{geo.Point{Latitude: 1.3, Longitude: 103.8}, geo.Point{Latitude: 1.301, Longitude: 103.8}, 0},
+	}
+	if len(flow.Movements) != 2 {
+		t.Fatalf("got %+v want %+v", flow.Movements, want)
+	}
+	want[1].Distance = flow.Movements[1].Distance
+	if !reflect.DeepEqual(flow.Movements, want) {
+		t.Errorf("got %+v want %+v", flow.Movements, want)
+	}
+	if d := flow.Movements[1].Distance; d < 111 || d > 112 {
+		t.Errorf("got %v want about %v", d, 111.2)
+	}
+	if got, want := flow.Appeared, []geo.Point{{Latitude: 1.4, Longitude: 103.7}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if got, want := flow.Disappeared, []geo.Point{{Latitude: 1.35, Longitude: 103.9}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestMatchNearest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomPoints := func(n int) []geo.Point {
+		points := make([]geo.Point, n)
+		for i := range points {
+			points[i] = geo.Point{Latitude: 1.2 + r.Float64()*0.28, Longitude: 103.6 + r.Float64()*0.45}
+		}
+		return points
+	}
+	from, to := randomPoints(200), randomPoints(150)
+
+	// Create test cases
+	cases := []struct {
+		name        string
+		maxDistance float64
+	}{
+		{"near", 1000},
+		{"far", 100000},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Compare with greedy matching over every pair
+			var pairs []pointMatch
+			for i, p := range from {
+				for j, q := range to {
+					if d := geo.Distance(p, q); d <= tc.maxDistance {
+						pairs = append(pairs, pointMatch{i, j, d})
+					}
+				}
+			}
+			h := pointMatchHeap(pairs)
+			sort.Slice(h, h.Less)
+			var want []pointMatch
+			fromPaired, toPaired := make([]bool, len(from)), make([]bool, len(to))
+			for _, p := range h {
+				if !fromPaired[p.from] && !toPaired[p.to] {
+					fromPaired[p.from], toPaired[p.to] = true, true
+					want = append(want, p)
+				}
+			}
+			got := matchNearest(from, to, tc.maxDistance)
+			if len(got) != len(want) {
+				t.Fatalf("got %d pairs want %d", len(got), len(want))
+			}
+			for k := range want {
+				if got[k].from != want[k].from || got[k].to != want[k].to || math.Abs(got[k].distance-want[k].distance) > 1e-6 {
+					t.Errorf("pair %d: got %+v want %+v", k, got[k], want[k])
+				}
+			}
+		})
+	}
+}
+
+func TestTaxiFlow_Aggregate(t *testing.T) {
+	pa := loadPlanningAreas(t)
+	flow := &TaxiFlow{
+		Movements: []TaxiMovement{
+			{From: geo.Point{Latitude: 1.36, Longitude: 103.96}, To: geo.Point{Latitude: 1.29, Longitude: 103.85}},
+			{From: geo.Point{Latitude: 1.36, Longitude: 103.96}, To: geo.Point{Latitude: 1.29, Longitude: 103.85}},
+			{From: geo.Point{Latitude: 1.36, Longitude: 103.96}, To: geo.Point{Latitude: 1.352, Longitude: 103.952}},
+			{From: geo.Point{Latitude: 1.36, Longitude: 103.96}, To: geo.Point{Latitude: 1.45, Longitude: 103.80}},
+		},
+		Appeared:    []geo.Point{{Latitude: 1.29, Longitude: 103.85}},
+		Disappeared: []geo.Point{{Latitude: 1.36, Longitude: 103.96}, {Latitude: 1.45, Longitude: 103.80}},
+	}
+	want := &TaxiFlowSummary{
+		Flows: []TaxiODFlow{
+			{"TAMPINES", "DOWNTOWN CORE", 2},
+			{"TAMPINES", "TAMPINES", 1},
+		},
+		Appeared:    map[string]int{"DOWNTOWN CORE": 1},
+		Disappeared: map[string]int{"TAMPINES": 1},
+	}
+	if got := flow.Aggregate(pa.PlanningAreaZones()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestTaxiFlow_Aggregate_Tiling(t *testing.T) {
+	flow := &TaxiFlow{
+		Movements: []TaxiMovement{
+			{From: geo.Point{Latitude: 1.3, Longitude: 103.8}, To: geo.Point{Latitude: 1.4, Longitude: 103.9}},
+		},
+	}
+	tiling := geo.GeohashTiling{Precision: 5}
+	got := flow.Aggregate(TilingZones(tiling))
+	want := []TaxiODFlow{{tiling.Key(flow.Movements[0].From), tiling.Key(flow.Movements[0].To), 1}}
+	if !reflect.DeepEqual(got.Flows, want) {
+		t.Errorf("got %+v want %+v", got.Flows, want)
+	}
+}
@@ -0,0 +1,93 @@
+package datagovsg
+
+import (
+	"sort"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// HeatmapCell represents the number of available taxis in a cell of a
+// heatmap.
+type HeatmapCell struct {
+	// Key of the cell in the tiling, e.g. a geohash
+	Key string
+
+	// Total number of taxis counted in the cell across all snapshots
+	Count int
+
+	// Average number of taxis in the cell per snapshot
+	Density float64
+
+	// Boundary of the cell
+	Boundary geo.Ring
+}
+
+// TaxiHeatmap bins available taxis into the cells of a tiling, such as
+// geo.GeohashTiling or geo.HexTiling, accumulating counts across
+// snapshots.
+type TaxiHeatmap struct {
+	// Tiling used to bin taxis
+	Tiling geo.Tiling
+
+	counts    map[string]int
+	snapshots int
+	points    []geo.Point
+}
+
+// NewTaxiHeatmap returns a new empty TaxiHeatmap over the tiling.
+func NewTaxiHeatmap(tiling geo.Tiling) *TaxiHeatmap {
+	return &TaxiHeatmap{
+		Tiling: tiling,
+		counts: make(map[string]int),
+	}
+}
+
+// Add counts the available taxis in a snapshot.
+func (h *TaxiHeatmap) Add(t *TaxiAvailability) {
+	h.points = t.appendPoints(h.points[:0])
+	for _, p := range h.points {
+		h.counts[h.Tiling.Key(p)]++
+	}
+	h.snapshots++
+}
+
+// Snapshots returns the number of snapshots added to the heatmap.
+func (h *TaxiHeatmap) Snapshots() int {
+	return h.snapshots
+}
+
+// Reset removes all snapshots from the heatmap.
+func (h *TaxiHeatmap) Reset() {
+	h.counts = make(map[string]int)
+	h.snapshots = 0
+}
+
+// Cells returns the cells with at least one taxi, ordered by decreasing
+// count and then by key.
+func (h *TaxiHeatmap) Cells() []HeatmapCell {
+	cells := make([]HeatmapCell, 0, len(h.counts))
+	for key, count := range h.counts {
+		boundary, _ := h.Tiling.Boundary(key)
+		cells = append(cells, HeatmapCell{
+			Key:      key,
+			Count:    count,
+			Density:  float64(count) / float64(h.snapshots),
+			Boundary: boundary,
+		})
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Count != cells[j].Count {
+			return cells[i].Count > cells[j].Count
+		}
+		return cells[i].Key < cells[j].Key
+	})
+	return cells
+}
+
+// Heatmap returns the available taxis in the snapshot binned into the
+// cells of a tiling.
+func (t *TaxiAvailability) Heatmap(tiling geo.Tiling) []HeatmapCell {
+	h := NewTaxiHeatmap(tiling)
+	h.Add(t)
+	return h.Cells()
+}
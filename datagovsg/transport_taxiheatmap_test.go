@@ -0,0 +1,72 @@
+package datagovsg
+
+import (
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+func TestTaxiAvailability_Heatmap(t *testing.T) {
+	taxis := taxiSnapshot(
+		[2]float64{1.3000, 103.8000},
+		[2]float64{1.3001, 103.8001},
+		[2]float64{1.4000, 103.9000},
+	)
+	cells := taxis.Heatmap(geo.GeohashTiling{Precision: 6})
+	if len(cells) != 2 {
+		t.Fatalf("got %v cells want %v", len(cells), 2)
+	}
+	if got, want := cells[0].Key, geo.EncodeGeohash(geo.Point{Latitude: 1.3, Longitude: 103.8}, 6); got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if got, want := cells[0].Count, 2; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if !cells[0].Boundary.Contains(geo.Point{Latitude: 1.3, Longitude: 103.8}) {
+		t.Errorf("got boundary %+v not containing taxi", cells[0].Boundary)
+	}
+}
+
+func TestTaxiHeatmap(t *testing.T) {
+	h := NewTaxiHeatmap(geo.HexTiling{Size: 500})
+	h.Add(taxiSnapshot([2]float64{1.3, 103.8}, [2]float64{1.3, 103.8}))
+	h.Add(taxiSnapshot([2]float64{1.3, 103.8}, [2]float64{1.4, 103.9}))
+	if got, want := h.Snapshots(), 2; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+	cells := h.Cells()
+	if len(cells) != 2 {
+		t.Fatalf("got %v cells want %v", len(cells), 2)
+	}
+
+	// Create test cases
+	cases := []struct {
+		name    string
+		cell    HeatmapCell
+		count   int
+		density float64
+	}{
+		{"busy", cells[0], 3, 1.5},
+		{"quiet", cells[1], 1, 0.5},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.cell.Count != tc.count || tc.cell.Density != tc.density {
+				t.Errorf("got (%v, %v) want (%v, %v)", tc.cell.Count, tc.cell.Density, tc.count, tc.density)
+			}
+			if len(tc.cell.Boundary) != 6 {
+				t.Errorf("got %v vertices want %v", len(tc.cell.Boundary), 6)
+			}
+		})
+	}
+
+	h.Reset()
+	if got := h.Cells(); len(got) != 0 || h.Snapshots() != 0 {
+		t.Errorf("got %+v cells after reset", got)
+	}
+}
@@ -0,0 +1,84 @@
+package datagovsg
+
+import (
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// TaxiDistance represents an available taxi found by a spatial query.
+type TaxiDistance struct {
+	geo.Point
+
+	// Distance to the query location in metres
+	Distance float64
+}
+
+// TaxiIndex is a spatial index over the available taxis in a
+// TaxiAvailability snapshot supporting radius, bounding box and k-nearest
+// queries.
+//
+// A TaxiIndex is meant to be updated with every new snapshot: Update
+// reuses the memory of the previous snapshot. A TaxiIndex is not safe for
+// concurrent use while it is being updated.
+type TaxiIndex struct {
+	grid   *geo.Grid
+	points []geo.Point
+}
+
+// NewTaxiIndex returns a new empty TaxiIndex.
+func NewTaxiIndex() *TaxiIndex {
+	return &TaxiIndex{grid: geo.NewGrid(0)}
+}
+
+// Update replaces the indexed taxis with those in the snapshot.
+func (ix *TaxiIndex) Update(t *TaxiAvailability) {
+	ix.points = t.appendPoints(ix.points[:0])
+	ix.grid.Reset(ix.points)
+}
+
+// Len returns the number of taxis in the index.
+func (ix *TaxiIndex) Len() int {
+	return ix.grid.Len()
+}
+
+// Count returns the number of taxis within the radius in metres of a
+// location.
+func (ix *TaxiIndex) Count(latitude, longitude, radius float64) int {
+	return ix.grid.Count(geo.Point{Latitude: latitude, Longitude: longitude}, radius)
+}
+
+// Within returns the taxis within the radius in metres of a location,
+// ordered by increasing distance.
+func (ix *TaxiIndex) Within(latitude, longitude, radius float64) []TaxiDistance {
+	return ix.taxis(ix.grid.Within(geo.Point{Latitude: latitude, Longitude: longitude}, radius))
+}
+
+// Nearest returns up to k taxis nearest to a location, ordered by
+// increasing distance.
+func (ix *TaxiIndex) Nearest(latitude, longitude float64, k int) []TaxiDistance {
+	return ix.taxis(ix.grid.Nearest(geo.Point{Latitude: latitude, Longitude: longitude}, k))
+}
+
+// InBBox returns the taxis within a bounding box.
+func (ix *TaxiIndex) InBBox(b geo.BBox) []geo.Point {
+	indices := ix.grid.InBBox(b)
+	if indices == nil {
+		return nil
+	}
+	points := make([]geo.Point, len(indices))
+	for i, j := range indices {
+		points[i] = ix.grid.Point(j)
+	}
+	return points
+}
+
+// taxis converts neighbors found in the grid into taxis.
+func (ix *TaxiIndex) taxis(neighbors []geo.Neighbor) []TaxiDistance {
+	if neighbors == nil {
+		return nil
+	}
+	taxis := make([]TaxiDistance, len(neighbors))
+	for i, n := range neighbors {
+		taxis[i] = TaxiDistance{ix.grid.Point(n.Index), n.Distance}
+	}
+	return taxis
+}
@@ -0,0 +1,74 @@
+package datagovsg
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// taxiSnapshot returns a TaxiAvailability with taxis at the given
+// latitude and longitude pairs.
+func taxiSnapshot(coords ...[2]float64) *TaxiAvailability {
+	t := &TaxiAvailability{Features: []TaxiAvailabilityFeature{{}}}
+	for _, c := range coords {
+		t.Features[0].Geometry.Coordinates = append(t.Features[0].Geometry.Coordinates, TaxiAvailabilityFeatureGeometryCoordinates{c[1], c[0]})
+	}
+	return t
+}
+
+func TestTaxiIndex(t *testing.T) {
+	ix := NewTaxiIndex()
+	ix.Update(taxiSnapshot(
+		[2]float64{1.3000, 103.8000},
+		[2]float64{1.3030, 103.8000},
+		[2]float64{1.3100, 103.8000},
+		[2]float64{1.4000, 103.9000},
+	))
+	if got, want := ix.Len(), 4; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	// Radius queries
+	if got, want := ix.Count(1.3, 103.8, 500), 2; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+	within := ix.Within(1.3, 103.8, 500)
+	if len(within) != 2 || within[0].Latitude != 1.3 || within[1].Latitude != 1.303 {
+		t.Errorf("got %+v want taxis at 1.3 and 1.303", within)
+	}
+	if d := within[1].Distance; math.Abs(d-333.6) > 0.1 {
+		t.Errorf("got %v want %v", d, 333.6)
+	}
+
+	// Nearest queries
+	nearest := ix.Nearest(1.311, 103.8, 2)
+	if len(nearest) != 2 || nearest[0].Latitude != 1.31 || nearest[1].Latitude != 1.303 {
+		t.Errorf("got %+v want taxis at 1.31 and 1.303", nearest)
+	}
+
+	// Bounding box queries
+	b := geo.BBox{Min: geo.Point{Latitude: 1.35, Longitude: 103.85}, Max: geo.Point{Latitude: 1.45, Longitude: 103.95}}
+	if got, want := ix.InBBox(b), []geo.Point{{Latitude: 1.4, Longitude: 103.9}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	// Updating replaces the previous snapshot
+	ix.Update(taxiSnapshot([2]float64{1.4, 103.9}))
+	if got, want := ix.Count(1.3, 103.8, 500), 0; got != want {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestTaxiIndex_Fixture(t *testing.T) {
+	var taxis TaxiAvailability
+	loadFixture(t, "testdata/fixtures/transport_taxiavailability_default.json", &taxis)
+	ix := NewTaxiIndex()
+	ix.Update(&taxis)
+	got := ix.Nearest(1.31675, 103.6409, 1)
+	want := []TaxiDistance{{geo.Point{Latitude: 1.31675, Longitude: 103.6409}, 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
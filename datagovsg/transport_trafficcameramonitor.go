@@ -0,0 +1,272 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// The default duration after which a repeated frame is frozen.
+	cameraFrozenAfter = 30 * time.Minute
+
+	// The default lag after which a camera timestamp is stale.
+	cameraStaleAfter = 15 * time.Minute
+
+	// The size of the fingerprints used to compare frames.
+	fingerprintWidth  = 32
+	fingerprintHeight = 18
+)
+
+// CameraStatus represents the health of a traffic camera.
+type CameraStatus string
+
+const (
+	// CameraStatusOK represents a camera serving fresh frames.
+	CameraStatusOK CameraStatus = "ok"
+
+	// CameraStatusFrozen represents a camera serving the same frame with
+	// advancing timestamps.
+	CameraStatusFrozen CameraStatus = "frozen"
+
+	// CameraStatusStale represents a camera whose timestamp lags behind
+	// the timestamp of the snapshot.
+	CameraStatusStale CameraStatus = "stale"
+
+	// CameraStatusOffline represents a camera missing from the latest
+	// snapshot.
+	CameraStatusOffline CameraStatus = "offline"
+)
+
+// CameraHealth represents the health of a traffic camera as of the latest
+// snapshot.
+type CameraHealth struct {
+	// Camera ID provided by LTA
+	CameraID string
+
+	// Status of the camera
+	Status CameraStatus
+
+	// Time the camera has been in the status since, which is when the
+	// frame was first served for frozen cameras, the camera timestamp for
+	// stale cameras and the last snapshot including the camera for
+	// offline cameras. It is zero for healthy cameras.
+	Since time.Time
+
+	// Duration the camera has been in the status as of the latest snapshot
+	Duration time.Duration
+}
+
+// CameraMonitor detects frozen, stale and offline traffic cameras by
+// comparing successive snapshots of traffic images.
+//
+// Frames are considered repeated if they have the same MD5 hash. If
+// Similarity is set, frames with different hashes are also downloaded and
+// compared pixel by pixel, so that re-encoded copies of the same frame
+// are considered repeated too.
+//
+// A CameraMonitor is safe for concurrent use, although Health and
+// Unhealthy block while an Update is downloading frames.
+type CameraMonitor struct {
+	// Duration after which a repeated frame is considered frozen, which
+	// defaults to 30 minutes
+	FrozenAfter time.Duration
+
+	// Lag after which a camera timestamp is considered stale, which
+	// defaults to 15 minutes
+	StaleAfter time.Duration
+
+	// Minimum similarity between 0 and 1 of decoded frames for them to be
+	// considered repeated, or zero to compare hashes only
+	Similarity float64
+
+	// Client used to download frames for pixel comparison
+	Client *Client
+
+	mu      sync.RWMutex
+	cameras map[string]*cameraState
+	latest  time.Time
+}
+
+// cameraState represents the history of a camera across snapshots.
+type cameraState struct {
+	md5         string
+	fingerprint []uint8
+	frameSince  time.Time
+	timestamp   time.Time
+	lastSeen    time.Time
+}
+
+// NewCameraMonitor returns a new CameraMonitor comparing frames by their
+// hashes.
+func NewCameraMonitor() *CameraMonitor {
+	return &CameraMonitor{
+		FrozenAfter: cameraFrozenAfter,
+		StaleAfter:  cameraStaleAfter,
+		cameras:     make(map[string]*cameraState),
+	}
+}
+
+// Update adds the items of a snapshot to the history of the monitor.
+// Cameras in items older than the last item including them are ignored.
+//
+// The first error parsing a timestamp is returned once the whole snapshot
+// has been processed, and cameras with invalid timestamps are given the
+// timestamp of their item. Likewise if pixel comparison is enabled, the
+// first error downloading or decoding a frame is returned, and the frames
+// affected are compared by their hashes only.
+func (m *CameraMonitor) Update(ctx context.Context, t *TrafficImages) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cameras == nil {
+		m.cameras = make(map[string]*cameraState)
+	}
+	var firstErr error
+	for _, item := range t.Items {
+		ts, err := time.Parse(time.RFC3339, item.Timestamp)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if ts.After(m.latest) {
+			m.latest = ts
+		}
+		for _, camera := range item.Cameras {
+			if err := m.observe(ctx, ts, camera); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// observe records the frame served by a camera in a snapshot.
+func (m *CameraMonitor) observe(ctx context.Context, ts time.Time, camera TrafficImagesCamera) error {
+	st, ok := m.cameras[camera.CameraID]
+	if !ok {
+		st = &cameraState{}
+		m.cameras[camera.CameraID] = st
+	}
+	if ts.Before(st.lastSeen) {
+		return nil
+	}
+	st.lastSeen = ts
+	t, err := time.Parse(time.RFC3339, camera.Timestamp)
+	if err != nil {
+		err = fmt.Errorf("camera %v: %w", camera.CameraID, err)
+		t = ts
+	}
+	st.timestamp = t
+
+	hash := camera.ImageMetadata.MD5
+	if ok && hash != "" && hash == st.md5 {
+		return err
+	}
+	repeated := false
+	if m.Similarity > 0 && m.Client != nil {
+		fp, fpErr := m.fingerprint(ctx, camera)
+		if fpErr == nil {
+			repeated = st.fingerprint != nil && fingerprintSimilarity(st.fingerprint, fp) >= m.Similarity
+			st.fingerprint = fp
+		} else if err == nil {
+			err = fpErr
+		}
+	}
+	st.md5 = hash
+	if !repeated {
+		st.frameSince = ts
+	}
+	return err
+}
+
+// fingerprint downloads the frame of a camera and returns it scaled down
+// to grayscale pixels.
+func (m *CameraMonitor) fingerprint(ctx context.Context, camera TrafficImagesCamera) ([]uint8, error) {
+	data, err := m.Client.GetTrafficImage(ctx, camera)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	small := scaleImage(img, fingerprintWidth, fingerprintHeight)
+	fp := make([]uint8, 0, fingerprintWidth*fingerprintHeight)
+	for i := 0; i < len(small.Pix); i += 4 {
+		// ITU-R BT.601 luma
+		y := (299*uint32(small.Pix[i]) + 587*uint32(small.Pix[i+1]) + 114*uint32(small.Pix[i+2])) / 1000
+		fp = append(fp, uint8(y))
+	}
+	return fp, nil
+}
+
+// fingerprintSimilarity returns the similarity between 0 and 1 of two
+// fingerprints, as one less their mean absolute difference.
+func fingerprintSimilarity(a, b []uint8) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var diff int
+	for i := range a {
+		d := int(a[i]) - int(b[i])
+		if d < 0 {
+			d = -d
+		}
+		diff += d
+	}
+	return 1 - float64(diff)/float64(255*len(a))
+}
+
+// Health returns the health of every camera seen, sorted by camera ID.
+func (m *CameraMonitor) Health() []CameraHealth {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	frozenAfter, staleAfter := m.FrozenAfter, m.StaleAfter
+	if frozenAfter <= 0 {
+		frozenAfter = cameraFrozenAfter
+	}
+	if staleAfter <= 0 {
+		staleAfter = cameraStaleAfter
+	}
+	health := make([]CameraHealth, 0, len(m.cameras))
+	for id, st := range m.cameras {
+		h := CameraHealth{CameraID: id, Status: CameraStatusOK}
+		switch {
+		case st.lastSeen.Before(m.latest):
+			h.Status = CameraStatusOffline
+			h.Since = st.lastSeen
+		case m.latest.Sub(st.timestamp) > staleAfter:
+			h.Status = CameraStatusStale
+			h.Since = st.timestamp
+		case m.latest.Sub(st.frameSince) >= frozenAfter:
+			h.Status = CameraStatusFrozen
+			h.Since = st.frameSince
+		}
+		if h.Status != CameraStatusOK {
+			h.Duration = m.latest.Sub(h.Since)
+		}
+		health = append(health, h)
+	}
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].CameraID < health[j].CameraID
+	})
+	return health
+}
+
+// Unhealthy returns the health of the frozen, stale and offline cameras,
+// sorted by camera ID.
+func (m *CameraMonitor) Unhealthy() []CameraHealth {
+	var unhealthy []CameraHealth
+	for _, h := range m.Health() {
+		if h.Status != CameraStatusOK {
+			unhealthy = append(unhealthy, h)
+		}
+	}
+	return unhealthy
+}
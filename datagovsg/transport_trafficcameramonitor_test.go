@@ -0,0 +1,187 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/jpeg"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// cameraSnapshot returns a snapshot of traffic images at the given time,
+// with each camera given as its ID, timestamp and MD5 hash.
+func cameraSnapshot(ts time.Time, cameras ...[3]string) *TrafficImages {
+	item := TrafficImagesItem{Timestamp: ts.Format(time.RFC3339)}
+	for _, c := range cameras {
+		item.Cameras = append(item.Cameras, TrafficImagesCamera{
+			CameraID:      c[0],
+			Timestamp:     c[1],
+			ImageMetadata: TrafficImagesCameraImageMetadata{MD5: c[2]},
+		})
+	}
+	return &TrafficImages{Items: []TrafficImagesItem{item}}
+}
+
+func TestCameraMonitor_Health(t *testing.T) {
+	start := time.Date(2020, 5, 1, 8, 0, 0, 0, sgt)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	ts := func(minutes int) string { return at(minutes).Format(time.RFC3339) }
+
+	// Camera 1001 is healthy, 1002 repeats its frame, 1003 stops updating
+	// its timestamp and 1004 disappears after 20 minutes
+	m := NewCameraMonitor()
+	for i := 0; i <= 60; i += 5 {
+		hash := string(rune('a' + i/5))
+		cameras := [][3]string{
+			{"1001", ts(i), hash},
+			{"1002", ts(i), "frozen"},
+			{"1003", ts(0), hash},
+		}
+		if i <= 20 {
+			cameras = append(cameras, [3]string{"1004", ts(i), hash})
+		}
+		if err := m.Update(context.Background(), cameraSnapshot(at(i), cameras...)); err != nil {
+			t.Fatalf("failed to update monitor: %v", err)
+		}
+	}
+
+	// Cameras in snapshots older than their last are ignored, while other
+	// cameras are still recorded
+	if err := m.Update(context.Background(), cameraSnapshot(at(10), [3]string{"1004", ts(10), "x"}, [3]string{"1005", ts(10), "x"})); err != nil {
+		t.Fatalf("failed to update monitor: %v", err)
+	}
+
+	want := []CameraHealth{
+		{CameraID: "1001", Status: CameraStatusOK},
+		{CameraID: "1002", Status: CameraStatusFrozen, Since: at(0), Duration: time.Hour},
+		{CameraID: "1003", Status: CameraStatusStale, Since: at(0), Duration: time.Hour},
+		{CameraID: "1004", Status: CameraStatusOffline, Since: at(20), Duration: 40 * time.Minute},
+		{CameraID: "1005", Status: CameraStatusOffline, Since: at(10), Duration: 50 * time.Minute},
+	}
+	got := m.Health()
+	for i := range got {
+		if !got[i].Since.Equal(want[i].Since) {
+			t.Errorf("camera %v: got since %v want %v", got[i].CameraID, got[i].Since, want[i].Since)
+		}
+		got[i].Since = want[i].Since
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if got := m.Unhealthy(); !reflect.DeepEqual(got, m.Health()[1:]) {
+		t.Errorf("got %+v want %+v", got, m.Health()[1:])
+	}
+}
+
+func TestCameraMonitor_Update_Timestamp(t *testing.T) {
+	start := time.Date(2020, 5, 1, 8, 0, 0, 0, sgt)
+	m := NewCameraMonitor()
+
+	// Invalid camera timestamps are returned after the snapshot is recorded
+	err := m.Update(context.Background(), cameraSnapshot(start, [3]string{"1001", "invalid", "a"}, [3]string{"1002", start.Format(time.RFC3339), "b"}))
+	var perr *time.ParseError
+	if !errors.As(err, &perr) {
+		t.Errorf("expected error '%T' but got: %v", perr, err)
+	}
+	want := []CameraHealth{
+		{CameraID: "1001", Status: CameraStatusOK},
+		{CameraID: "1002", Status: CameraStatusOK},
+	}
+	if got := m.Health(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestCameraMonitor_Concurrency(t *testing.T) {
+	start := time.Date(2020, 5, 1, 8, 0, 0, 0, sgt)
+	m := NewCameraMonitor()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			at := start.Add(time.Duration(i) * time.Minute)
+			if err := m.Update(context.Background(), cameraSnapshot(at, [3]string{"1001", at.Format(time.RFC3339), "a"})); err != nil {
+				t.Errorf("failed to update monitor: %v", err)
+			}
+			m.Unhealthy()
+		}(i)
+	}
+	wg.Wait()
+	if got := m.Health(); len(got) != 1 || got[0].Status != CameraStatusOK {
+		t.Errorf("got %+v want camera %v ok", got, "1001")
+	}
+}
+
+func TestCameraMonitor_Similarity(t *testing.T) {
+	// The same frame encoded at different quality levels, and a different
+	// frame
+	encode := func(v uint8, quality int) []byte {
+		img := image.NewGray(image.Rect(0, 0, 64, 36))
+		for i := range img.Pix {
+			img.Pix[i] = v + uint8(i%7)
+		}
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+			t.Fatalf("failed to encode image: %v", err)
+		}
+		return buf.Bytes()
+	}
+	frames := map[string][]byte{
+		"/a.jpg": encode(100, 90),
+		"/b.jpg": encode(100, 70),
+		"/c.jpg": encode(200, 90),
+	}
+	// Create test cases
+	cases := []struct {
+		name       string
+		similarity float64
+		paths      []string
+		want       CameraStatus
+	}{
+		{"reencoded", 0.98, []string{"/a.jpg", "/b.jpg", "/a.jpg", "/b.jpg"}, CameraStatusFrozen},
+		{"hash_only", 0, []string{"/a.jpg", "/b.jpg", "/a.jpg", "/b.jpg"}, CameraStatusOK},
+		{"changed", 0.98, []string{"/a.jpg", "/c.jpg", "/a.jpg", "/c.jpg"}, CameraStatusOK},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock HTTP server
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(frames[r.URL.Path])
+			}))
+			defer server.Close()
+
+			// Update monitor
+			m := NewCameraMonitor()
+			m.FrozenAfter = 15 * time.Minute
+			m.Similarity = tc.similarity
+			m.Client = NewClient()
+			start := time.Date(2020, 5, 1, 8, 0, 0, 0, sgt)
+			for i, path := range tc.paths {
+				ts := start.Add(time.Duration(i) * 5 * time.Minute)
+				sum := md5.Sum(frames[path])
+				s := cameraSnapshot(ts, [3]string{"1001", ts.Format(time.RFC3339), hex.EncodeToString(sum[:])})
+				s.Items[0].Cameras[0].Image = server.URL + path
+				if err := m.Update(context.Background(), s); err != nil {
+					t.Fatalf("failed to update monitor: %v", err)
+				}
+			}
+			if got := m.Health()[0].Status; got != tc.want {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
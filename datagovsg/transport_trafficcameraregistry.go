@@ -0,0 +1,260 @@
+package datagovsg
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Expressways maps the abbreviations of Singapore expressways to their
+// full names.
+var Expressways = map[string]string{
+	"AYE": "Ayer Rajah Expressway",
+	"BKE": "Bukit Timah Expressway",
+	"CTE": "Central Expressway",
+	"ECP": "East Coast Parkway",
+	"KJE": "Kranji Expressway",
+	"KPE": "Kallang-Paya Lebar Expressway",
+	"MCE": "Marina Coastal Expressway",
+	"PIE": "Pan Island Expressway",
+	"SLE": "Seletar Expressway",
+	"TPE": "Tampines Expressway",
+}
+
+// CameraInfo represents the location of a traffic camera in terms of
+// roads and landmarks.
+type CameraInfo struct {
+	// Camera ID provided by LTA
+	CameraID string `csv:"camera_id"`
+
+	// Road on which the camera is located, given as the abbreviation for
+	// expressways, e.g. "PIE"
+	Road string `csv:"road"`
+
+	// Place towards which traffic in view is heading, e.g. "Changi"
+	Direction string `csv:"direction"`
+
+	// Landmark near the camera, e.g. "Adam Flyover"
+	Description string `csv:"description"`
+}
+
+// OnRoad returns true if the camera is located on the road, given either
+// as it is in the registry or as the full name of an expressway. Roads
+// are compared case-insensitively.
+func (ci CameraInfo) OnRoad(road string) bool {
+	road = strings.TrimSpace(road)
+	return strings.EqualFold(ci.Road, road) || strings.EqualFold(Expressways[ci.Road], road)
+}
+
+// CameraRegistry maps the IDs of traffic cameras to the roads and
+// landmarks at which they are located. It is safe for concurrent use.
+type CameraRegistry struct {
+	mu      sync.RWMutex
+	cameras map[string]CameraInfo
+}
+
+// DefaultCameraRegistry is the registry used by TrafficImagesCamera.Info,
+// initially holding the embedded camera locations. It can be kept up to
+// date with CameraRegistry.Update as LTA adds or relocates cameras.
+var DefaultCameraRegistry = mustLoadCameraRegistry(defaultCameraRegistryCSV)
+
+// NewCameraRegistry returns a new CameraRegistry of the given cameras.
+func NewCameraRegistry(cameras ...CameraInfo) *CameraRegistry {
+	r := &CameraRegistry{cameras: make(map[string]CameraInfo, len(cameras))}
+	r.Update(cameras...)
+	return r
+}
+
+// LoadCameraRegistry reads a CameraRegistry from a CSV file with the
+// header camera_id,road,direction,description.
+func LoadCameraRegistry(rd io.Reader) (*CameraRegistry, error) {
+	var cameras []CameraInfo
+	d := NewCSVDecoder(rd)
+	for d.Next() {
+		var ci CameraInfo
+		if err := d.Decode(&ci); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCSVDecode, err)
+		}
+		cameras = append(cameras, ci)
+	}
+	if err := d.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCSVDecode, err)
+	}
+	return NewCameraRegistry(cameras...), nil
+}
+
+// mustLoadCameraRegistry returns the CameraRegistry of a CSV file and
+// panics if it cannot be read.
+func mustLoadCameraRegistry(s string) *CameraRegistry {
+	r, err := LoadCameraRegistry(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Update adds the cameras to the registry, replacing those with the same
+// camera IDs.
+func (r *CameraRegistry) Update(cameras ...CameraInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, ci := range cameras {
+		ci.CameraID = strings.TrimSpace(ci.CameraID)
+		if ci.CameraID == "" {
+			continue
+		}
+		r.cameras[ci.CameraID] = ci
+	}
+}
+
+// Len returns the number of cameras in the registry.
+func (r *CameraRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.cameras)
+}
+
+// Lookup returns a camera by its ID.
+func (r *CameraRegistry) Lookup(cameraID string) (CameraInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ci, ok := r.cameras[cameraID]
+	return ci, ok
+}
+
+// Cameras returns the cameras in the registry sorted by camera ID.
+func (r *CameraRegistry) Cameras() []CameraInfo {
+	return r.Search("", "")
+}
+
+// Search returns the cameras on a road with traffic heading in a
+// direction, sorted by camera ID. An empty road or direction matches all
+// cameras, and both are compared case-insensitively.
+//
+// For example, Search("PIE", "Changi") returns the cameras on the PIE
+// towards Changi.
+func (r *CameraRegistry) Search(road, direction string) []CameraInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var cameras []CameraInfo
+	for _, ci := range r.cameras {
+		if road != "" && !ci.OnRoad(road) {
+			continue
+		}
+		if direction != "" && !strings.EqualFold(ci.Direction, strings.TrimSpace(direction)) {
+			continue
+		}
+		cameras = append(cameras, ci)
+	}
+	sort.Slice(cameras, func(i, j int) bool {
+		return cameras[i].CameraID < cameras[j].CameraID
+	})
+	return cameras
+}
+
+// Filter returns the traffic cameras on a road with traffic heading in a
+// direction, matched as by Search. Cameras missing from the registry are
+// left out unless both road and direction are empty.
+func (r *CameraRegistry) Filter(cameras []TrafficImagesCamera, road, direction string) []TrafficImagesCamera {
+	ids := make(map[string]bool)
+	for _, ci := range r.Search(road, direction) {
+		ids[ci.CameraID] = true
+	}
+	var filtered []TrafficImagesCamera
+	for _, c := range cameras {
+		if ids[c.CameraID] || road == "" && direction == "" {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// Info returns the road, direction and landmark of the camera from the
+// DefaultCameraRegistry.
+func (c TrafficImagesCamera) Info() (CameraInfo, bool) {
+	return DefaultCameraRegistry.Lookup(c.CameraID)
+}
+
+// defaultCameraRegistryCSV holds the locations of traffic cameras as
+// described by LTA. Roads are given as expressway abbreviations where the
+// camera is on an expressway, and directions as the place towards which
+// traffic in view is heading.
+const defaultCameraRegistryCSV = `camera_id,road,direction,description
+1111,TPE,PIE,Exit 2 to Loyang Avenue
+1112,TPE,PIE,Tampines Viaduct
+1113,Tanah Merah Coast Road,Changi,Tanah Merah Coast Road
+1701,CTE,AYE,Moulmein Flyover
+1702,CTE,AYE,Braddell Flyover
+1703,CTE,SLE,St George's Road
+1704,CTE,AYE,Entrance from Chin Swee Road
+1705,CTE,AYE,Ang Mo Kio Avenue 5 Flyover
+1706,CTE,AYE,Yio Chu Kang Flyover
+1707,CTE,AYE,Bukit Merah Flyover
+1709,CTE,AYE,Exit 6 to Bukit Timah Road
+1711,CTE,AYE,Ang Mo Kio Flyover
+2701,Woodlands Causeway,Johor,Woodlands Causeway
+2702,Woodlands Checkpoint,,Woodlands Checkpoint
+2703,BKE,PIE,Chantek Flyover
+2704,BKE,Woodlands Checkpoint,Woodlands Flyover
+2705,BKE,PIE,Dairy Farm Flyover
+2706,BKE,Woodlands Checkpoint,Entrance from Mandai Road
+2707,BKE,PIE,Exit 5 to KJE
+2708,BKE,Woodlands Checkpoint,Exit 5 to KJE
+3702,ECP,Changi,Entrance from PIE
+3704,ECP,Changi,Entrance from KPE
+3705,ECP,AYE,Exit 2A to Changi Coast Road
+3793,ECP,Changi,Laguna Flyover
+3795,ECP,City,Marine Parade Flyover
+3796,ECP,Changi,Tanjong Katong Flyover
+3797,ECP,City,Tanjong Rhu
+3798,ECP,Changi,Benjamin Sheares Bridge
+4701,AYE,City,Exit to Alexandra Road
+4702,AYE,Jurong,Keppel Viaduct
+4704,AYE,CTE,Lower Delta Road Flyover
+4705,AYE,MCE,Entrance from Yuan Ching Road
+4706,AYE,Jurong,NUS School of Computing
+4707,AYE,MCE,Entrance from Jalan Ahmad Ibrahim
+4708,AYE,CTE,ITE College West Dover
+4710,AYE,Tuas,Pandan Gardens
+4712,AYE,Tuas,Exit to Tuas Avenue 8
+4713,Tuas Checkpoint,,Tuas Checkpoint
+4714,AYE,Tuas,West Coast Walk
+4716,AYE,Tuas,Entrance from Benoi Road
+5794,PIE,Jurong,Bedok North
+5795,PIE,Jurong,Eunos Flyover
+5797,PIE,Jurong,Paya Lebar Flyover
+5798,PIE,Jurong,Kallang Sims Drive
+5799,PIE,Changi,Woodsville Flyover
+6701,PIE,Changi,Jalan Tenteram
+6703,PIE,Changi,Toa Payoh Lorong 1
+6704,PIE,Jurong,Mount Pleasant Flyover
+6705,PIE,Changi,Adam Flyover
+6706,PIE,Changi,BKE
+6708,PIE,Changi,Nanyang Flyover
+6710,PIE,Changi,Entrance from Jalan Anak Bukit
+6711,PIE,Jurong,Entrance from ECP
+6712,PIE,Jurong,Exit 27 to Clementi Avenue 6
+6713,PIE,Jurong,Entrance from Simei Avenue
+6714,PIE,Changi,Exit 35 to KJE
+6715,PIE,Jurong,Hong Kah Flyover
+6716,PIE,Jurong,AYE Flyover
+7791,TPE,PIE,Upper Changi Flyover
+7793,TPE,PIE,Entrance from Tampines Avenue 10
+7794,TPE,SLE,Exit to KPE
+7795,TPE,PIE,Entrance from Tampines Flyover
+7796,TPE,SLE,Rivervale Drive
+7797,TPE,PIE,Seletar Flyover
+7798,TPE,SLE,SLE Flyover
+8701,KJE,PIE,Choa Chu Kang West Flyover
+8702,KJE,BKE,Exit to BKE
+8704,KJE,BKE,Entrance from Choa Chu Kang Drive
+8706,KJE,BKE,Tengah Flyover
+9701,SLE,TPE,Lentor Flyover
+9702,SLE,TPE,Thomson Flyover
+9703,SLE,Woodlands,Woodlands South Flyover
+9704,SLE,TPE,Ulu Sembawang Flyover
+9705,SLE,TPE,Slip Road from Woodlands Avenue 2
+9706,SLE,Woodlands,Mandai Lake Flyover
+`
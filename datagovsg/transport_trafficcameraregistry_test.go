@@ -0,0 +1,134 @@
+package datagovsg
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCameraRegistry_Search(t *testing.T) {
+	r := NewCameraRegistry(
+		CameraInfo{CameraID: "6705", Road: "PIE", Direction: "Changi", Description: "Adam Flyover"},
+		CameraInfo{CameraID: "6704", Road: "PIE", Direction: "Jurong", Description: "Mount Pleasant Flyover"},
+		CameraInfo{CameraID: "5799", Road: "PIE", Direction: "Changi", Description: "Woodsville Flyover"},
+		CameraInfo{CameraID: "1701", Road: "CTE", Direction: "AYE", Description: "Moulmein Flyover"},
+		CameraInfo{CameraID: "2702", Road: "Woodlands Checkpoint", Description: "Woodlands Checkpoint"},
+	)
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		road      string
+		direction string
+		want      []string
+	}{
+		{"road_direction", "PIE", "Changi", []string{"5799", "6705"}},
+		{"case_insensitive", "pie", "CHANGI", []string{"5799", "6705"}},
+		{"full_name", "Pan Island Expressway", "", []string{"5799", "6704", "6705"}},
+		{"direction", "", "AYE", []string{"1701"}},
+		{"other_road", "woodlands checkpoint", "", []string{"2702"}},
+		{"all", "", "", []string{"1701", "2702", "5799", "6704", "6705"}},
+		{"none", "KJE", "", nil},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, ci := range r.Search(tc.road, tc.direction) {
+				got = append(got, ci.CameraID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoadCameraRegistry(t *testing.T) {
+	csv := "camera_id,road,direction,description\n" +
+		"1701,CTE,AYE,Moulmein Flyover\n" +
+		"9999,KPE,ECP,Test Camera\n"
+	r, err := LoadCameraRegistry(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("failed to load registry: %v", err)
+	}
+	want := CameraInfo{CameraID: "9999", Road: "KPE", Direction: "ECP", Description: "Test Camera"}
+	if got, ok := r.Lookup("9999"); !ok || got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	// Update replaces existing cameras
+	want = CameraInfo{CameraID: "1701", Road: "CTE", Direction: "SLE", Description: "Moulmein Flyover"}
+	r.Update(want, CameraInfo{Road: "PIE"})
+	if got, ok := r.Lookup("1701"); !ok || got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if got := r.Len(); got != 2 {
+		t.Errorf("got %d cameras want %d", got, 2)
+	}
+}
+
+func TestLoadCameraRegistry_Error(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated_quote", "camera_id,road\n\"1701,CTE\n"},
+		{"missing_field", "camera_id,road,direction\n1701,CTE\n"},
+		{"extra_field", "camera_id,road\n1701,CTE,AYE\n"},
+		{"bare_quote", "camera_id,road\n17\"01,CTE\n"},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := LoadCameraRegistry(strings.NewReader(tc.input)); !errors.Is(err, ErrCSVDecode) {
+				t.Errorf("expected error '%v' but got: %v", ErrCSVDecode, err)
+			}
+		})
+	}
+}
+
+func TestCameraRegistry_Filter(t *testing.T) {
+	// Load fixture
+	ti := &TrafficImages{}
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", ti)
+	cameras := ti.Items[0].Cameras
+
+	r := NewCameraRegistry(CameraInfo{CameraID: "1702", Road: "CTE", Direction: "AYE", Description: "Braddell Flyover"})
+	got := r.Filter(cameras, "Central Expressway", "AYE")
+	if len(got) != 1 || got[0].CameraID != "1702" {
+		t.Errorf("got %+v want camera %v", got, "1702")
+	}
+	if got := r.Filter(cameras, "", ""); len(got) != len(cameras) {
+		t.Errorf("got %d cameras want %d", len(got), len(cameras))
+	}
+}
+
+func TestTrafficImagesCamera_Info(t *testing.T) {
+	want := CameraInfo{CameraID: "1701", Road: "CTE", Direction: "AYE", Description: "Moulmein Flyover"}
+	if got, ok := (TrafficImagesCamera{CameraID: "1701"}).Info(); !ok || got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+	if _, ok := (TrafficImagesCamera{CameraID: "0000"}).Info(); ok {
+		t.Errorf("got info for unknown camera")
+	}
+
+	// Every embedded camera is on an expressway or has a road name
+	for _, ci := range DefaultCameraRegistry.Cameras() {
+		if ci.Road == "" || ci.Description == "" {
+			t.Errorf("camera %v: got %+v want road and description", ci.CameraID, ci)
+		}
+		if _, ok := Expressways[ci.Road]; !ok && strings.ToUpper(ci.Road) == ci.Road {
+			t.Errorf("camera %v: got unknown expressway %v", ci.CameraID, ci.Road)
+		}
+	}
+}
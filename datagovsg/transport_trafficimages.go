@@ -1,6 +1,7 @@
 package datagovsg
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
@@ -66,6 +67,12 @@ type TrafficImagesCameraImageMetadata struct {
 // GetTrafficImages returns the latest images from traffic
 // cameras all around Singapore.
 func (c *Client) GetTrafficImages(options ...*QueryOption) (*TrafficImages, error) {
+	return c.getTrafficImages(context.Background(), options...)
+}
+
+// getTrafficImages returns the images from traffic cameras, executing the
+// request with the given context.
+func (c *Client) getTrafficImages(ctx context.Context, options ...*QueryOption) (*TrafficImages, error) {
 	// Parse URL
 	path := "/v1/transport/traffic-images/"
 	u, err := url.Parse(c.BaseURL + path)
@@ -81,7 +88,7 @@ func (c *Client) GetTrafficImages(options ...*QueryOption) (*TrafficImages, erro
 	u.RawQuery = v.Encode()
 
 	// Execute request
-	b, err := c.Get(u)
+	b, err := c.GetContext(ctx, u)
 	if err != nil {
 		return nil, err
 	}
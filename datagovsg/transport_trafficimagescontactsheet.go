@@ -0,0 +1,235 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"sort"
+	"sync"
+)
+
+const (
+	// The default size of the thumbnails of a contact sheet.
+	contactSheetThumbnailWidth  = 240
+	contactSheetThumbnailHeight = 135
+
+	// The spacing between the thumbnails of a contact sheet in pixels.
+	contactSheetGap = 4
+
+	// The group of cameras without a group on a contact sheet.
+	contactSheetOtherGroup = "Other"
+)
+
+var (
+	// The background of a contact sheet.
+	contactSheetBackground = color.RGBA{0x20, 0x20, 0x20, 0xff}
+
+	// The placeholder of cameras whose images could not be downloaded.
+	contactSheetPlaceholder = color.RGBA{0x60, 0x00, 0x00, 0xff}
+)
+
+// ContactSheet renders the current images of traffic cameras as a single
+// image, with labelled thumbnails laid out in a grid.
+type ContactSheet struct {
+	// Client used to download images
+	Client *Client
+
+	// Size of the thumbnails, which images are scaled to fit within
+	ThumbnailWidth  int
+	ThumbnailHeight int
+
+	// Number of thumbnails per row, or zero to lay thumbnails out in a
+	// square grid
+	Columns int
+
+	// Function returning the group of a camera, or nil to lay all cameras
+	// out together. Groups are laid out in alphabetical order under a
+	// heading, with cameras in no group last.
+	GroupBy func(TrafficImagesCamera) string
+
+	// Maximum number of concurrent downloads
+	Concurrency int
+}
+
+// NewContactSheet returns a new ContactSheet with 240x135 thumbnails.
+func NewContactSheet(c *Client) *ContactSheet {
+	return &ContactSheet{
+		Client:          c,
+		ThumbnailWidth:  contactSheetThumbnailWidth,
+		ThumbnailHeight: contactSheetThumbnailHeight,
+		Concurrency:     imageDownloadConcurrency,
+	}
+}
+
+// GroupByRoad returns a function grouping cameras by their expressways in
+// the registry, for use as ContactSheet.GroupBy. Cameras on other roads or
+// missing from the registry are in no group.
+func GroupByRoad(r *CameraRegistry) func(TrafficImagesCamera) string {
+	return func(c TrafficImagesCamera) string {
+		ci, _ := r.Lookup(c.CameraID)
+		if _, ok := Expressways[ci.Road]; !ok {
+			return ""
+		}
+		return ci.Road
+	}
+}
+
+// contactSheetGroup represents a group of cameras on a contact sheet.
+type contactSheetGroup struct {
+	name    string
+	cameras []int
+}
+
+// Render downloads the images of the cameras and returns the contact
+// sheet. Cameras whose images cannot be downloaded are shown as a
+// placeholder, and the first such error is returned along with the sheet.
+func (s *ContactSheet) Render(ctx context.Context, cameras []TrafficImagesCamera) (*image.RGBA, error) {
+	thumbs, err := s.thumbnails(ctx, cameras)
+	tw, th := s.thumbnailSize()
+
+	// Group cameras
+	groups := []*contactSheetGroup{{}}
+	if s.GroupBy != nil {
+		byName := make(map[string]*contactSheetGroup)
+		groups = groups[:0]
+		for i, c := range cameras {
+			name := s.GroupBy(c)
+			g, ok := byName[name]
+			if !ok {
+				g = &contactSheetGroup{name: name}
+				byName[name] = g
+				groups = append(groups, g)
+			}
+			g.cameras = append(g.cameras, i)
+		}
+		sort.SliceStable(groups, func(i, j int) bool {
+			if (groups[i].name == "") != (groups[j].name == "") {
+				return groups[j].name == ""
+			}
+			return groups[i].name < groups[j].name
+		})
+		for _, g := range groups {
+			if g.name == "" {
+				g.name = contactSheetOtherGroup
+			}
+		}
+	} else {
+		for i := range cameras {
+			groups[0].cameras = append(groups[0].cameras, i)
+		}
+	}
+
+	// Lay out grid
+	cols := s.Columns
+	if cols <= 0 {
+		cols = int(math.Ceil(math.Sqrt(float64(len(cameras)))))
+	}
+	if cols < 1 {
+		cols = 1
+	}
+	headingScale := 2
+	headingHeight := 0
+	if s.GroupBy != nil {
+		headingHeight = labelSize("", headingScale).Y + contactSheetGap
+	}
+	height := contactSheetGap
+	for _, g := range groups {
+		rows := (len(g.cameras) + cols - 1) / cols
+		height += headingHeight + rows*(th+contactSheetGap)
+	}
+	width := cols*(tw+contactSheetGap) + contactSheetGap
+
+	// Draw sheet
+	sheet := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(sheet, sheet.Bounds(), image.NewUniform(contactSheetBackground), image.Point{}, draw.Src)
+	labelScale := tw / 160
+	if labelScale < 1 {
+		labelScale = 1
+	}
+	y := contactSheetGap
+	for _, g := range groups {
+		if s.GroupBy != nil {
+			drawLabel(sheet, image.Pt(contactSheetGap, y), g.name, headingScale)
+			y += headingHeight
+		}
+		for k, i := range g.cameras {
+			x := contactSheetGap + (k%cols)*(tw+contactSheetGap)
+			ty := y + (k/cols)*(th+contactSheetGap)
+			tile := image.Rect(x, ty, x+tw, ty+th)
+			if thumbs[i] == nil {
+				draw.Draw(sheet, tile, image.NewUniform(contactSheetPlaceholder), image.Point{}, draw.Src)
+			} else {
+				draw.Draw(sheet, tile, image.Black, image.Point{}, draw.Src)
+				b := thumbs[i].Bounds()
+				offset := image.Pt(x+(tw-b.Dx())/2, ty+(th-b.Dy())/2)
+				draw.Draw(sheet, b.Add(offset), thumbs[i], b.Min, draw.Src)
+			}
+			label := labelSize(cameras[i].CameraID, labelScale)
+			drawLabel(sheet, image.Pt(x, ty+th-label.Y), cameras[i].CameraID, labelScale)
+		}
+		y += (len(g.cameras) + cols - 1) / cols * (th + contactSheetGap)
+	}
+	return sheet, err
+}
+
+// thumbnailSize returns the size of the thumbnails, using the defaults for
+// unset dimensions.
+func (s *ContactSheet) thumbnailSize() (int, int) {
+	tw, th := s.ThumbnailWidth, s.ThumbnailHeight
+	if tw <= 0 {
+		tw = contactSheetThumbnailWidth
+	}
+	if th <= 0 {
+		th = contactSheetThumbnailHeight
+	}
+	return tw, th
+}
+
+// thumbnails concurrently downloads the images of the cameras and scales
+// them to fit within the thumbnails. Images that cannot be downloaded or
+// decoded are left nil, and the first such error is returned.
+func (s *ContactSheet) thumbnails(ctx context.Context, cameras []TrafficImagesCamera) ([]image.Image, error) {
+	tw, th := s.thumbnailSize()
+	thumbs := make([]image.Image, len(cameras))
+	errs := make([]error, len(cameras))
+	n := s.Concurrency
+	if n <= 0 {
+		n = 1
+	}
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+	for i := range cameras {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			data, err := s.Client.GetTrafficImage(ctx, cameras[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			img, _, err := image.Decode(bytes.NewReader(data))
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			thumbs[i] = scaleToFit(img, tw, th)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return thumbs, err
+		}
+	}
+	return thumbs, nil
+}
@@ -0,0 +1,178 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupByRoad(t *testing.T) {
+	groupBy := GroupByRoad(NewCameraRegistry(
+		CameraInfo{CameraID: "6705", Road: "PIE"},
+		CameraInfo{CameraID: "1001", Road: "Sentosa Gateway"},
+	))
+
+	// Create test cases
+	cases := []struct {
+		camera string
+		want   string
+	}{
+		{"6705", "PIE"},
+		{"1001", ""},
+		{"9999", ""},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.camera, func(t *testing.T) {
+			t.Parallel()
+
+			if got := groupBy(TrafficImagesCamera{CameraID: tc.camera}); got != tc.want {
+				t.Errorf("got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContactSheet_Render(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name    string
+		columns int
+		groupBy func(TrafficImagesCamera) string
+		width   int
+		height  int
+	}{
+		// 2 columns of 80x45 thumbnails in 2 rows
+		{"grid", 0, nil, 2*84 + 4, 2*49 + 4},
+		// 3 columns in 1 row
+		{"columns", 3, nil, 3*84 + 4, 49 + 4},
+		// PIE with 2 cameras in 1 row, then other with 1 camera not on an
+		// expressway, each under a heading of 22 pixels
+		{"grouped", 2, GroupByRoad(NewCameraRegistry(
+			CameraInfo{CameraID: "6705", Road: "PIE"},
+			CameraInfo{CameraID: "6704", Road: "PIE"},
+			CameraInfo{CameraID: "1001", Road: "Sentosa Gateway"},
+		)), 2*84 + 4, 2*(26+49) + 4},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock HTTP server
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/missing" {
+					http.NotFound(w, r)
+					return
+				}
+				img := image.NewGray(image.Rect(0, 0, 64, 48))
+				for i := range img.Pix {
+					img.Pix[i] = 200
+				}
+				jpeg.Encode(w, img, nil)
+			}))
+			defer server.Close()
+
+			// Render sheet
+			cameras := []TrafficImagesCamera{
+				{CameraID: "6705", Image: server.URL + "/6705.jpg"},
+				{CameraID: "1001", Image: server.URL + "/missing"},
+				{CameraID: "6704", Image: server.URL + "/6704.jpg"},
+			}
+			s := NewContactSheet(NewClient())
+			s.ThumbnailWidth = 80
+			s.ThumbnailHeight = 45
+			s.Columns = tc.columns
+			s.GroupBy = tc.groupBy
+			sheet, err := s.Render(context.Background(), cameras)
+			if !errors.Is(err, ErrResponseNotOk) {
+				t.Errorf("got %v want %v", err, ErrResponseNotOk)
+			}
+			if got := sheet.Bounds().Size(); got != image.Pt(tc.width, tc.height) {
+				t.Fatalf("got %v want %v", got, image.Pt(tc.width, tc.height))
+			}
+
+			// Images fit the height of thumbnails, as 60x45 centred between
+			// black bars, and missing images are shown as placeholders
+			var gray, placeholder int
+			for y := 0; y < tc.height; y++ {
+				for x := 0; x < tc.width; x++ {
+					switch c := sheet.RGBAAt(x, y); {
+					case c.R > 180 && c.R < 220 && c.R == c.G:
+						gray++
+					case c == contactSheetPlaceholder:
+						placeholder++
+					}
+				}
+			}
+			if label := labelSize("6705", 1); gray < 2*(60*45-label.X*label.Y) || gray > 2*60*45 {
+				t.Errorf("got %d image pixels want about %d", gray, 2*60*45)
+			}
+			if placeholder == 0 {
+				t.Errorf("got no placeholder pixels")
+			}
+		})
+	}
+}
+
+func TestContactSheet_RenderLabel(t *testing.T) {
+	// Mock HTTP server
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	// Render sheet of a single placeholder narrower than 160 pixels
+	s := NewContactSheet(NewClient())
+	s.ThumbnailWidth = 80
+	s.ThumbnailHeight = 45
+	sheet, err := s.Render(context.Background(), []TrafficImagesCamera{
+		{CameraID: "6705", Image: server.URL + "/6705.jpg"},
+	})
+	if !errors.Is(err, ErrResponseNotOk) {
+		t.Errorf("got %v want %v", err, ErrResponseNotOk)
+	}
+
+	// Label pixels lie within the tile
+	tile := image.Rect(contactSheetGap, contactSheetGap, contactSheetGap+80, contactSheetGap+45)
+	var label int
+	for y := sheet.Bounds().Min.Y; y < sheet.Bounds().Max.Y; y++ {
+		for x := sheet.Bounds().Min.X; x < sheet.Bounds().Max.X; x++ {
+			if sheet.RGBAAt(x, y) != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+				continue
+			}
+			label++
+			if !image.Pt(x, y).In(tile) {
+				t.Fatalf("got label pixel at %v outside tile %v", image.Pt(x, y), tile)
+			}
+		}
+	}
+	if label == 0 {
+		t.Errorf("got no label pixels")
+	}
+}
+
+func TestEncodeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	img.Set(1, 1, color.White)
+	for _, format := range []ImageFormat{ImageFormatJPEG, ImageFormatPNG} {
+		var buf bytes.Buffer
+		if err := EncodeImage(&buf, img, format); err != nil {
+			t.Fatalf("failed to encode %v: %v", format, err)
+		}
+		if _, got, err := image.Decode(&buf); err != nil || got != string(format) {
+			t.Errorf("got %v %v want %v", got, err, format)
+		}
+	}
+	if err := EncodeImage(&bytes.Buffer{}, img, "bmp"); !errors.Is(err, ErrImageFormat) {
+		t.Errorf("got %v want %v", err, ErrImageFormat)
+	}
+}
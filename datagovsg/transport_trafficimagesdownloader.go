@@ -0,0 +1,285 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // register JPEG decoder for traffic images
+	_ "image/png"  // register PNG decoder for traffic images
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// The default number of concurrent image downloads.
+	imageDownloadConcurrency = 4
+
+	// The layout of timestamps in the file names of stored images.
+	imageFileTimeLayout = "20060102T150405Z0700"
+)
+
+var (
+	// ErrImageChecksum is returned when the MD5 hash of a downloaded
+	// traffic image does not match its metadata.
+	ErrImageChecksum = errors.New("datagovsg: image checksum mismatch")
+
+	// ErrImageDimensions is returned when the dimensions of a downloaded
+	// traffic image do not match its metadata.
+	ErrImageDimensions = errors.New("datagovsg: image dimensions mismatch")
+)
+
+// GetTrafficImage downloads the image of a traffic camera and verifies it
+// against the image metadata. Checks are skipped for metadata fields that
+// are not set.
+func (c *Client) GetTrafficImage(ctx context.Context, camera TrafficImagesCamera) ([]byte, error) {
+	// Execute request
+	req, err := http.NewRequestWithContext(ctx, "GET", camera.Image, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %v", ErrResponseNotOk, resp.Status)
+	}
+
+	// Verify image
+	if want := camera.ImageMetadata.MD5; want != "" {
+		sum := md5.Sum(b)
+		if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, want) {
+			return nil, fmt.Errorf("%w: camera %v: got %v want %v", ErrImageChecksum, camera.CameraID, got, want)
+		}
+	}
+	if camera.ImageMetadata.Width > 0 || camera.ImageMetadata.Height > 0 {
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("%w: camera %v: %v", ErrImageDimensions, camera.CameraID, err)
+		}
+		if cfg.Width != camera.ImageMetadata.Width || cfg.Height != camera.ImageMetadata.Height {
+			return nil, fmt.Errorf("%w: camera %v: got %vx%v want %vx%v", ErrImageDimensions, camera.CameraID,
+				cfg.Width, cfg.Height, camera.ImageMetadata.Width, camera.ImageMetadata.Height)
+		}
+	}
+	return b, nil
+}
+
+// ImageStore stores traffic images keyed by camera ID and timestamp.
+type ImageStore interface {
+	// Has returns true if an image of the camera with the given MD5 hash
+	// is stored.
+	Has(cameraID, md5 string) (bool, error)
+
+	// Put stores the image of a camera taken at the given time.
+	Put(cameraID string, timestamp time.Time, md5 string, data []byte) error
+}
+
+// FileImageStore is an ImageStore keeping images in a directory on the
+// filesystem, as Dir/<camera ID>/<timestamp>_<md5>.<ext>.
+//
+// The MD5 hash is part of the file name so that stored images can be
+// recognised without reading them.
+type FileImageStore struct {
+	// Directory of the store
+	Dir string
+
+	mu     sync.Mutex
+	hashes map[imageKey]bool
+}
+
+// imageKey identifies a stored image by camera and MD5 hash, as cameras
+// may return identical images, e.g. a placeholder while offline.
+type imageKey struct {
+	camera string
+	md5    string
+}
+
+// newImageKey returns the key of an image of a camera with the given MD5
+// hash, matching the directory and file names of the store.
+func newImageKey(cameraID, md5 string) imageKey {
+	return imageKey{filepath.Base(cameraID), strings.ToLower(md5)}
+}
+
+// NewFileImageStore returns a new FileImageStore in the given directory.
+func NewFileImageStore(dir string) *FileImageStore {
+	return &FileImageStore{Dir: dir}
+}
+
+// load indexes the hashes of the stored images. It must be called with
+// the lock held.
+func (s *FileImageStore) load() error {
+	if s.hashes != nil {
+		return nil
+	}
+	hashes := make(map[imageKey]bool)
+	cameras, err := ioutil.ReadDir(s.Dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, camera := range cameras {
+		if !camera.IsDir() {
+			continue
+		}
+		files, err := ioutil.ReadDir(filepath.Join(s.Dir, camera.Name()))
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			name := strings.TrimSuffix(f.Name(), filepath.Ext(f.Name()))
+			if i := strings.LastIndexByte(name, '_'); i >= 0 {
+				hashes[newImageKey(camera.Name(), name[i+1:])] = true
+			}
+		}
+	}
+	s.hashes = hashes
+	return nil
+}
+
+// Has returns true if an image of the camera with the given MD5 hash is
+// stored.
+func (s *FileImageStore) Has(cameraID, md5 string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(); err != nil {
+		return false, err
+	}
+	return s.hashes[newImageKey(cameraID, md5)], nil
+}
+
+// Put stores the image of a camera taken at the given time.
+func (s *FileImageStore) Put(cameraID string, timestamp time.Time, md5 string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	dir := filepath.Join(s.Dir, filepath.Base(cameraID))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	ext := ".jpg"
+	if _, format, err := image.DecodeConfig(bytes.NewReader(data)); err == nil && format != "jpeg" {
+		ext = "." + format
+	}
+	name := timestamp.Format(imageFileTimeLayout) + "_" + strings.ToLower(md5) + ext
+	if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		return err
+	}
+	s.hashes[newImageKey(cameraID, md5)] = true
+	return nil
+}
+
+// ImageDownloadResult represents the outcome of downloading the image of
+// a traffic camera.
+type ImageDownloadResult struct {
+	// Camera whose image was downloaded
+	Camera TrafficImagesCamera
+
+	// Whether the download was skipped as the image was already stored
+	Skipped bool
+
+	// Error downloading, verifying or storing the image, if any
+	Err error
+}
+
+// ImageDownloader concurrently downloads traffic camera images into an
+// ImageStore, skipping images that are already stored.
+type ImageDownloader struct {
+	// Client used to download images
+	Client *Client
+
+	// Store in which images are kept
+	Store ImageStore
+
+	// Maximum number of concurrent downloads
+	Concurrency int
+}
+
+// NewImageDownloader returns a new ImageDownloader.
+func NewImageDownloader(c *Client, store ImageStore) *ImageDownloader {
+	return &ImageDownloader{
+		Client:      c,
+		Store:       store,
+		Concurrency: imageDownloadConcurrency,
+	}
+}
+
+// Download downloads the images of the cameras, returning the results in
+// the same order as the cameras.
+func (d *ImageDownloader) Download(ctx context.Context, cameras []TrafficImagesCamera) []ImageDownloadResult {
+	results := make([]ImageDownloadResult, len(cameras))
+	n := d.Concurrency
+	if n <= 0 {
+		n = 1
+	}
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+	for i, camera := range cameras {
+		results[i].Camera = camera
+		wg.Add(1)
+		go func(r *ImageDownloadResult) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+				return
+			}
+			r.Skipped, r.Err = d.download(ctx, r.Camera)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// DownloadLatest downloads the images of the cameras in the latest item.
+func (d *ImageDownloader) DownloadLatest(ctx context.Context, t *TrafficImages) []ImageDownloadResult {
+	if len(t.Items) == 0 {
+		return nil
+	}
+	return d.Download(ctx, t.Items[len(t.Items)-1].Cameras)
+}
+
+// download downloads and stores the image of a camera, returning true if
+// the image was already stored.
+func (d *ImageDownloader) download(ctx context.Context, camera TrafficImagesCamera) (bool, error) {
+	if camera.ImageMetadata.MD5 != "" {
+		ok, err := d.Store.Has(camera.CameraID, camera.ImageMetadata.MD5)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	ts, err := time.Parse(time.RFC3339, camera.Timestamp)
+	if err != nil {
+		return false, err
+	}
+	b, err := d.Client.GetTrafficImage(ctx, camera)
+	if err != nil {
+		return false, err
+	}
+	hash := camera.ImageMetadata.MD5
+	if hash == "" {
+		sum := md5.Sum(b)
+		hash = hex.EncodeToString(sum[:])
+		if ok, err := d.Store.Has(camera.CameraID, hash); err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, d.Store.Put(camera.CameraID, ts, hash, b)
+}
@@ -0,0 +1,146 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+// testTrafficImage returns a JPEG image of the given dimensions and its
+// MD5 hash.
+func testTrafficImage(t *testing.T, width, height int) ([]byte, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatalf("failed to encode image: %v", err)
+	}
+	sum := md5.Sum(buf.Bytes())
+	return buf.Bytes(), hex.EncodeToString(sum[:])
+}
+
+// newTrafficImageServer returns a mock server serving the image at any
+// path other than /missing, counting the requests made.
+func newTrafficImageServer(img []byte, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(img)
+	}))
+}
+
+func TestClient_GetTrafficImage(t *testing.T) {
+	img, hash := testTrafficImage(t, 32, 24)
+
+	// Create test cases
+	cases := []struct {
+		name     string
+		path     string
+		metadata TrafficImagesCameraImageMetadata
+		err      error
+	}{
+		{"ok", "/1001.jpg", TrafficImagesCameraImageMetadata{Height: 24, Width: 32, MD5: hash}, nil},
+		{"no_metadata", "/1001.jpg", TrafficImagesCameraImageMetadata{}, nil},
+		{"checksum", "/1001.jpg", TrafficImagesCameraImageMetadata{Height: 24, Width: 32, MD5: "0123456789abcdef0123456789abcdef"}, ErrImageChecksum},
+		{"dimensions", "/1001.jpg", TrafficImagesCameraImageMetadata{Height: 240, Width: 320, MD5: hash}, ErrImageDimensions},
+		{"not_found", "/missing", TrafficImagesCameraImageMetadata{}, ErrResponseNotOk},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock HTTP server
+			var requests int32
+			server := newTrafficImageServer(img, &requests)
+			defer server.Close()
+
+			// Execute request
+			camera := TrafficImagesCamera{CameraID: "1001", Image: server.URL + tc.path, ImageMetadata: tc.metadata}
+			got, err := NewClient().GetTrafficImage(context.Background(), camera)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("got %v want %v", err, tc.err)
+			}
+			if tc.err == nil && !bytes.Equal(got, img) {
+				t.Errorf("got %d bytes want %d bytes", len(got), len(img))
+			}
+		})
+	}
+}
+
+func TestImageDownloader_Download(t *testing.T) {
+	img, hash := testTrafficImage(t, 32, 24)
+	var requests int32
+	server := newTrafficImageServer(img, &requests)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "datagovsg")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	metadata := TrafficImagesCameraImageMetadata{Height: 24, Width: 32, MD5: hash}
+	cameras := []TrafficImagesCamera{
+		{Timestamp: "2020-03-01T12:00:00+08:00", Image: server.URL + "/1001.jpg", CameraID: "1001", ImageMetadata: metadata},
+		{Timestamp: "2020-03-01T12:00:00+08:00", Image: server.URL + "/missing", CameraID: "1002"},
+		{Timestamp: "2020-03-01T12:00:00+08:00", Image: server.URL + "/1003.jpg", CameraID: "1003", ImageMetadata: TrafficImagesCameraImageMetadata{Height: 240, Width: 320}},
+	}
+
+	// Execute downloads
+	d := NewImageDownloader(NewClient(), NewFileImageStore(dir))
+	results := d.Download(context.Background(), cameras)
+	if len(results) != len(cameras) {
+		t.Fatalf("got %d results want %d", len(results), len(cameras))
+	}
+	for i, want := range []error{nil, ErrResponseNotOk, ErrImageDimensions} {
+		if results[i].Camera.CameraID != cameras[i].CameraID {
+			t.Errorf("result %d: got camera %v want %v", i, results[i].Camera.CameraID, cameras[i].CameraID)
+		}
+		if !errors.Is(results[i].Err, want) || results[i].Skipped {
+			t.Errorf("result %d: got %+v want error %v", i, results[i], want)
+		}
+	}
+	path := filepath.Join(dir, "1001", "20200301T120000+0800_"+hash+".jpg")
+	if b, err := ioutil.ReadFile(path); err != nil || !bytes.Equal(b, img) {
+		t.Errorf("failed to read stored image %v: %v", path, err)
+	}
+
+	// Stored images are skipped without being requested, even by a new store
+	before := atomic.LoadInt32(&requests)
+	d = NewImageDownloader(NewClient(), NewFileImageStore(dir))
+	results = d.Download(context.Background(), cameras[:1])
+	if !results[0].Skipped || results[0].Err != nil {
+		t.Errorf("got %+v want skipped", results[0])
+	}
+	if got := atomic.LoadInt32(&requests); got != before {
+		t.Errorf("got %d requests want %d", got-before, 0)
+	}
+
+	// Identical images of other cameras are still stored
+	other := cameras[0]
+	other.CameraID = "1004"
+	results = d.Download(context.Background(), []TrafficImagesCamera{other})
+	if results[0].Skipped || results[0].Err != nil {
+		t.Errorf("got %+v want stored", results[0])
+	}
+	path = filepath.Join(dir, "1004", "20200301T120000+0800_"+hash+".jpg")
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("failed to stat stored image %v: %v", path, err)
+	}
+}
@@ -0,0 +1,236 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// The default interval between the date_time queries of a time-lapse.
+	timeLapseInterval = 5 * time.Minute
+
+	// The default delay between the frames of a time-lapse GIF.
+	timeLapseDelay = 500 * time.Millisecond
+
+	// The layout of the date_time query parameter.
+	dateTimeLayout = "2006-01-02T15:04:05"
+
+	// The layout of the timestamps overlaid on time-lapse frames.
+	timeLapseLabelLayout = "2006-01-02 15:04:05"
+)
+
+var (
+	// ErrNoFrames is returned when encoding a time-lapse without frames.
+	ErrNoFrames = errors.New("datagovsg: time-lapse has no frames")
+)
+
+// sgt is Singapore Standard Time, in which the date_time query parameter
+// is interpreted.
+var sgt = time.FixedZone("SGT", 8*60*60)
+
+// TimeLapseFrame represents a traffic image within a time-lapse.
+type TimeLapseFrame struct {
+	// Time the image was taken
+	Timestamp time.Time
+
+	// MD5 hash of the image as provided by LTA
+	MD5 string
+
+	// Image with its timestamp overlaid
+	Image image.Image
+}
+
+// TimeLapse represents the distinct images of a traffic camera over a
+// period of time, in chronological order.
+type TimeLapse struct {
+	// Camera ID provided by LTA
+	CameraID string
+
+	// Frames of the time-lapse
+	Frames []TimeLapseFrame
+
+	// Errors downloading or decoding images that were left out
+	Errors []error
+}
+
+// TimeLapseBuilder collects the images of traffic cameras over a period
+// of time by querying the traffic images at regular intervals.
+type TimeLapseBuilder struct {
+	// Client used to query traffic images and download images
+	Client *Client
+
+	// Interval between queries
+	Interval time.Duration
+
+	// Width to which frames are scaled, preserving their aspect ratio, or
+	// zero to keep their original size
+	Width int
+}
+
+// NewTimeLapseBuilder returns a new TimeLapseBuilder.
+func NewTimeLapseBuilder(c *Client) *TimeLapseBuilder {
+	return &TimeLapseBuilder{
+		Client:   c,
+		Interval: timeLapseInterval,
+	}
+}
+
+// Build returns the time-lapses of the given cameras between start and
+// end, keyed by camera ID. Images repeated across queries are included
+// once.
+//
+// Images that cannot be downloaded or decoded, such as those that have
+// expired, are left out and recorded in the errors of the time-lapse. An
+// error is returned only if a query fails.
+func (b *TimeLapseBuilder) Build(ctx context.Context, start, end time.Time, cameraIDs ...string) (map[string]*TimeLapse, error) {
+	lapses := make(map[string]*TimeLapse, len(cameraIDs))
+	seen := make(map[string]map[string]bool, len(cameraIDs))
+	for _, id := range cameraIDs {
+		lapses[id] = &TimeLapse{CameraID: id}
+		seen[id] = make(map[string]bool)
+	}
+	interval := b.Interval
+	if interval <= 0 {
+		interval = timeLapseInterval
+	}
+
+	for q := start; !q.After(end); q = q.Add(interval) {
+		t, err := b.Client.getTrafficImages(ctx, &QueryOption{Key: "date_time", Value: q.In(sgt).Format(dateTimeLayout)})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range t.Items {
+			for _, camera := range item.Cameras {
+				lapse, ok := lapses[camera.CameraID]
+				if !ok {
+					continue
+				}
+				key := camera.ImageMetadata.MD5
+				if key == "" {
+					key = camera.Image
+				}
+				if seen[camera.CameraID][key] {
+					continue
+				}
+				seen[camera.CameraID][key] = true
+
+				frame, err := b.frame(ctx, camera)
+				if err != nil {
+					lapse.Errors = append(lapse.Errors, err)
+					continue
+				}
+				lapse.Frames = append(lapse.Frames, frame)
+			}
+		}
+	}
+
+	for _, lapse := range lapses {
+		frames := lapse.Frames
+		sort.SliceStable(frames, func(i, j int) bool {
+			return frames[i].Timestamp.Before(frames[j].Timestamp)
+		})
+	}
+	return lapses, nil
+}
+
+// frame downloads the image of a camera and returns it as a frame.
+func (b *TimeLapseBuilder) frame(ctx context.Context, camera TrafficImagesCamera) (TimeLapseFrame, error) {
+	ts, err := time.Parse(time.RFC3339, camera.Timestamp)
+	if err != nil {
+		return TimeLapseFrame{}, err
+	}
+	data, err := b.Client.GetTrafficImage(ctx, camera)
+	if err != nil {
+		return TimeLapseFrame{}, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return TimeLapseFrame{}, fmt.Errorf("camera %v: %v", camera.CameraID, err)
+	}
+	return TimeLapseFrame{
+		Timestamp: ts,
+		MD5:       camera.ImageMetadata.MD5,
+		Image:     timestampOverlay(scaleToWidth(img, b.Width), ts),
+	}, nil
+}
+
+// timestampOverlay returns a copy of the image with the timestamp drawn
+// in its top left corner, in Singapore Standard Time.
+func timestampOverlay(img image.Image, ts time.Time) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	scale := b.Dx() / 320
+	drawLabel(dst, image.Point{}, ts.In(sgt).Format(timeLapseLabelLayout), scale)
+	return dst
+}
+
+// EncodeGIF writes the time-lapse to w as an animated GIF, showing each
+// frame for the given delay. A non-positive delay defaults to half a
+// second.
+func (tl *TimeLapse) EncodeGIF(w io.Writer, delay time.Duration) error {
+	if len(tl.Frames) == 0 {
+		return ErrNoFrames
+	}
+	if delay <= 0 {
+		delay = timeLapseDelay
+	}
+	anim := &gif.GIF{
+		Config: image.Config{ColorModel: color.Palette(palette.Plan9)},
+	}
+	for _, f := range tl.Frames {
+		b := f.Image.Bounds()
+		p := image.NewPaletted(image.Rect(0, 0, b.Dx(), b.Dy()), palette.Plan9)
+		draw.FloydSteinberg.Draw(p, p.Bounds(), f.Image, b.Min)
+		anim.Image = append(anim.Image, p)
+		anim.Delay = append(anim.Delay, int(delay/(10*time.Millisecond)))
+		if b.Dx() > anim.Config.Width {
+			anim.Config.Width = b.Dx()
+		}
+		if b.Dy() > anim.Config.Height {
+			anim.Config.Height = b.Dy()
+		}
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// WriteFrames writes the frames of the time-lapse to the directory as a
+// numbered sequence of PNG images, named <camera ID>_0001.png onwards.
+func (tl *TimeLapse) WriteFrames(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for i, f := range tl.Frames {
+		name := fmt.Sprintf("%s_%04d.png", filepath.Base(tl.CameraID), i+1)
+		if err := writePNG(filepath.Join(dir, name), f.Image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePNG writes the image to a file in PNG format.
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,173 @@
+package datagovsg
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTimeLapseServer returns a mock server of traffic images in which the
+// image of camera 1001 changes every ten minutes, and camera 1002 serves
+// an expired image.
+func newTimeLapseServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	images := make([][]byte, 6)
+	for i := range images {
+		img := image.NewGray(image.Rect(0, 0, 64, 48))
+		for j := range img.Pix {
+			img.Pix[j] = uint8(40 * i)
+		}
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, nil); err != nil {
+			t.Fatalf("failed to encode image: %v", err)
+		}
+		images[i] = buf.Bytes()
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/v1/transport/traffic-images/", func(w http.ResponseWriter, r *http.Request) {
+		q, err := time.ParseInLocation(dateTimeLayout, r.URL.Query().Get("date_time"), sgt)
+		if err != nil {
+			http.Error(w, `{"message":"invalid date_time"}`, http.StatusBadRequest)
+			return
+		}
+		i := q.Minute() / 10
+		ts := q.Truncate(10 * time.Minute).Format(time.RFC3339)
+		sum := md5.Sum(images[i])
+		json.NewEncoder(w).Encode(TrafficImages{Items: []TrafficImagesItem{{
+			Timestamp: q.Format(time.RFC3339),
+			Cameras: []TrafficImagesCamera{
+				{Timestamp: ts, Image: server.URL + "/images/" + string(rune('0'+i)) + ".jpg", CameraID: "1001",
+					ImageMetadata: TrafficImagesCameraImageMetadata{Height: 48, Width: 64, MD5: hex.EncodeToString(sum[:])}},
+				{Timestamp: ts, Image: server.URL + "/expired.jpg", CameraID: "1002"},
+			},
+		}}})
+	})
+	mux.HandleFunc("/images/", func(w http.ResponseWriter, r *http.Request) {
+		i := r.URL.Path[len("/images/")] - '0'
+		w.Write(images[i])
+	})
+	return server
+}
+
+func TestTimeLapseBuilder_Build(t *testing.T) {
+	// Mock HTTP server
+	server := newTimeLapseServer(t)
+	defer server.Close()
+
+	// Build time-lapses
+	client := NewClient()
+	client.BaseURL = server.URL
+	start := time.Date(2020, 5, 1, 8, 0, 0, 0, sgt)
+	lapses, err := NewTimeLapseBuilder(client).Build(context.Background(), start, start.Add(25*time.Minute), "1001", "1002", "9999")
+	if err != nil {
+		t.Fatalf("failed to build time-lapses: %v", err)
+	}
+
+	// Compare counts
+	cases := []struct {
+		cameraID string
+		frames   int
+		errors   int
+	}{
+		{"1001", 3, 0},
+		{"1002", 0, 1},
+		{"9999", 0, 0},
+	}
+	for _, tc := range cases {
+		lapse, ok := lapses[tc.cameraID]
+		if !ok {
+			t.Fatalf("missing time-lapse of camera %v", tc.cameraID)
+		}
+		if len(lapse.Frames) != tc.frames || len(lapse.Errors) != tc.errors {
+			t.Errorf("camera %v: got %d frames %d errors want %d frames %d errors", tc.cameraID, len(lapse.Frames), len(lapse.Errors), tc.frames, tc.errors)
+		}
+	}
+
+	// Compare frames
+	lapse := lapses["1001"]
+	for i, f := range lapse.Frames {
+		if want := start.Add(time.Duration(i) * 10 * time.Minute); !f.Timestamp.Equal(want) {
+			t.Errorf("frame %d: got %v want %v", i, f.Timestamp, want)
+		}
+		if got := f.Image.Bounds().Size(); got != image.Pt(64, 48) {
+			t.Errorf("frame %d: got size %v want %v", i, got, image.Pt(64, 48))
+		}
+		if r, _, _, _ := f.Image.At(0, 0).RGBA(); r != 0 {
+			t.Errorf("frame %d: got overlay pixel %v want black", i, f.Image.At(0, 0))
+		}
+	}
+
+	// Encode GIF
+	var buf bytes.Buffer
+	if err := lapse.EncodeGIF(&buf, time.Second); err != nil {
+		t.Fatalf("failed to encode GIF: %v", err)
+	}
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("failed to decode GIF: %v", err)
+	}
+	if len(anim.Image) != 3 || anim.Delay[0] != 100 {
+		t.Errorf("got %d frames with delay %d want %d frames with delay %d", len(anim.Image), anim.Delay[0], 3, 100)
+	}
+	if err := lapses["1002"].EncodeGIF(&buf, 0); err != ErrNoFrames {
+		t.Errorf("got %v want %v", err, ErrNoFrames)
+	}
+
+	// Write frames
+	dir, err := ioutil.TempDir("", "datagovsg")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := lapse.WriteFrames(dir); err != nil {
+		t.Fatalf("failed to write frames: %v", err)
+	}
+	for _, name := range []string{"1001_0001.png", "1001_0002.png", "1001_0003.png"} {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("failed to open frame: %v", err)
+		}
+		_, err = png.Decode(f)
+		f.Close()
+		if err != nil {
+			t.Errorf("failed to decode frame %v: %v", name, err)
+		}
+	}
+}
+
+func TestTimestampOverlay(t *testing.T) {
+	src := image.NewRGBA(image.Rect(10, 10, 650, 490))
+	for i := range src.Pix {
+		src.Pix[i] = 128
+	}
+	ts := time.Date(2020, 5, 1, 0, 3, 0, 0, time.UTC)
+	got := timestampOverlay(src, ts)
+	if got.Bounds() != image.Rect(0, 0, 640, 480) {
+		t.Fatalf("got bounds %v want %v", got.Bounds(), image.Rect(0, 0, 640, 480))
+	}
+
+	// The label is drawn at twice the font size and shows the time in SGT
+	want := image.NewRGBA(got.Bounds())
+	for i := range want.Pix {
+		want.Pix[i] = 128
+	}
+	drawLabel(want, image.Point{}, "2020-05-01 08:03:00", 2)
+	if !bytes.Equal(got.Pix, want.Pix) {
+		t.Errorf("got overlay different from label %q", "2020-05-01 08:03:00")
+	}
+}
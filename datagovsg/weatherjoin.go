@@ -0,0 +1,220 @@
+package datagovsg
+
+import (
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+// NearestReading represents the reading of the station nearest to a
+// location.
+type NearestReading struct {
+	// ID of the station
+	StationID string
+
+	// Value of the reading
+	Value float64
+
+	// Distance of the station in metres
+	Distance float64
+}
+
+// AreaForecast represents the 2-hour weather forecast of the area
+// containing a location.
+type AreaForecast struct {
+	// Name of the forecast area
+	Area string
+
+	// Forecast of the area, e.g. "Partly Cloudy (Day)"
+	Forecast string
+
+	// Distance of the label location of the area in metres
+	Distance float64
+}
+
+// LocalWeather represents the current weather at a location. Fields are
+// nil if the corresponding resource was not given or has no data near the
+// location.
+type LocalWeather struct {
+	AirTemperature   *NearestReading
+	RelativeHumidity *NearestReading
+	Rainfall         *NearestReading
+	WindSpeed        *NearestReading
+	WindDirection    *NearestReading
+	Forecast         *AreaForecast
+}
+
+// CameraWeather represents a traffic camera with the weather at its
+// location.
+type CameraWeather struct {
+	TrafficImagesCamera
+	Weather LocalWeather
+}
+
+// CarparkWeather represents a carpark with the weather at its location.
+type CarparkWeather struct {
+	EnrichedCarpark
+	Weather LocalWeather
+}
+
+// PlaceWeather represents a place with the weather at its location.
+type PlaceWeather struct {
+	Place
+	Weather LocalWeather
+}
+
+// WeatherJoin attaches the current weather to locations, using the latest
+// reading of the nearest station of each measurement and the 2-hour
+// forecast of the area containing the location.
+type WeatherJoin struct {
+	// Maximum distance in metres of the nearest station, or 0 for no limit
+	MaxDistance float64
+
+	airTemperature   *nearestSamples
+	relativeHumidity *nearestSamples
+	rainfall         *nearestSamples
+	windSpeed        *nearestSamples
+	windDirection    *nearestSamples
+	forecast         *ForecastAreaResolver
+	labels           map[string]geo.Point
+}
+
+// nearestSamples is a spatial index over station readings.
+type nearestSamples struct {
+	samples []sample
+	index   *geo.Index
+}
+
+// newNearestSamples returns a new index over the samples, or nil if there
+// are no samples.
+func newNearestSamples(samples []sample) *nearestSamples {
+	if len(samples) == 0 {
+		return nil
+	}
+	points := make([]geo.Point, len(samples))
+	for i, s := range samples {
+		points[i] = s.point
+	}
+	return &nearestSamples{samples: samples, index: geo.NewIndex(points)}
+}
+
+// nearest returns the reading nearest to p within maxDistance metres, or
+// nil if there is none.
+func (ns *nearestSamples) nearest(p geo.Point, maxDistance float64) *NearestReading {
+	if ns == nil {
+		return nil
+	}
+	nearest := ns.index.Nearest(p, 1)
+	if len(nearest) == 0 || maxDistance > 0 && nearest[0].Distance > maxDistance {
+		return nil
+	}
+	s := ns.samples[nearest[0].Index]
+	return &NearestReading{StationID: s.stationID, Value: s.value, Distance: nearest[0].Distance}
+}
+
+// NewWeatherJoin returns a new WeatherJoin from the latest items of the
+// observations and forecast, either of which may be nil.
+func NewWeatherJoin(obs *WeatherObservations, forecast *TwoHourWeatherForecast) *WeatherJoin {
+	j := &WeatherJoin{}
+	if obs != nil {
+		if obs.AirTemperature != nil {
+			j.airTemperature = newNearestSamples(latestSamples(obs.AirTemperature))
+		}
+		if obs.RelativeHumidity != nil {
+			j.relativeHumidity = newNearestSamples(latestSamples(obs.RelativeHumidity))
+		}
+		if obs.Rainfall != nil {
+			j.rainfall = newNearestSamples(latestSamples(obs.Rainfall))
+		}
+		if obs.WindSpeed != nil {
+			j.windSpeed = newNearestSamples(latestSamples(obs.WindSpeed))
+		}
+		if obs.WindDirection != nil {
+			j.windDirection = newNearestSamples(latestSamples(obs.WindDirection))
+		}
+	}
+	if forecast != nil {
+		j.forecast = NewForecastAreaResolver(forecast)
+		j.labels = make(map[string]geo.Point, len(forecast.AreaMetadata))
+		for _, p := range forecast.Places() {
+			j.labels[p.Name] = p.Point()
+		}
+	}
+	return j
+}
+
+// At returns the current weather at the given coordinates.
+func (j *WeatherJoin) At(latitude, longitude float64) LocalWeather {
+	p := geo.Point{Latitude: latitude, Longitude: longitude}
+	w := LocalWeather{
+		AirTemperature:   j.airTemperature.nearest(p, j.MaxDistance),
+		RelativeHumidity: j.relativeHumidity.nearest(p, j.MaxDistance),
+		Rainfall:         j.rainfall.nearest(p, j.MaxDistance),
+		WindSpeed:        j.windSpeed.nearest(p, j.MaxDistance),
+		WindDirection:    j.windDirection.nearest(p, j.MaxDistance),
+	}
+	if j.forecast != nil {
+		if f, ok := j.forecast.Resolve(latitude, longitude); ok {
+			w.Forecast = &AreaForecast{
+				Area:     f.Area,
+				Forecast: f.Forecast,
+				Distance: geo.Distance(p, j.labels[f.Area]),
+			}
+		}
+	}
+	return w
+}
+
+// Join returns the current weather at the locations of n items, where
+// location returns the location of the i-th item and whether it is known.
+// The weather of items without a location is nil.
+//
+// Join works with items of any type, e.g.
+//
+//	weather := j.Join(len(stops), func(i int) (geo.Point, bool) {
+//		return stops[i].Location, true
+//	})
+func (j *WeatherJoin) Join(n int, location func(i int) (geo.Point, bool)) []*LocalWeather {
+	weather := make([]*LocalWeather, n)
+	for i := 0; i < n; i++ {
+		if p, ok := location(i); ok {
+			w := j.At(p.Latitude, p.Longitude)
+			weather[i] = &w
+		}
+	}
+	return weather
+}
+
+// Cameras returns the traffic cameras of the latest item with the weather
+// at their locations.
+func (j *WeatherJoin) Cameras(t *TrafficImages) []CameraWeather {
+	if len(t.Items) == 0 {
+		return nil
+	}
+	cameras := t.Items[len(t.Items)-1].Cameras
+	joined := make([]CameraWeather, len(cameras))
+	for i, c := range cameras {
+		joined[i] = CameraWeather{c, j.At(c.Location.Latitude, c.Location.Longitude)}
+	}
+	return joined
+}
+
+// Carparks returns the enriched carparks with the weather at their
+// locations. Carparks without information are omitted.
+func (j *WeatherJoin) Carparks(carparks []EnrichedCarpark) []CarparkWeather {
+	var joined []CarparkWeather
+	for _, c := range carparks {
+		if c.Information == nil {
+			continue
+		}
+		joined = append(joined, CarparkWeather{c, j.At(c.Information.Latitude, c.Information.Longitude)})
+	}
+	return joined
+}
+
+// Places returns the places with the weather at their locations.
+func (j *WeatherJoin) Places(places []Place) []PlaceWeather {
+	joined := make([]PlaceWeather, len(places))
+	for i, p := range places {
+		joined[i] = PlaceWeather{p, j.At(p.Latitude, p.Longitude)}
+	}
+	return joined
+}
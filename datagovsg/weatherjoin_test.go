@@ -0,0 +1,195 @@
+package datagovsg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/loozhengyuan/datagovsg-go/datagovsg/geo"
+)
+
+func TestWeatherJoin_At(t *testing.T) {
+	// Create test cases
+	cases := []struct {
+		name        string
+		latitude    float64
+		longitude   float64
+		maxDistance float64
+		station     string
+		value       float64
+		distance    float64
+	}{
+		{"east", 1.35, 103.805, 0, "E", 32, 556},
+		{"west", 1.35, 103.79, 0, "W", 30, 0},
+		// The station without a reading is not used even if nearest
+		{"skip_unread", 1.351, 103.801, 0, "E", 32, 1006},
+		{"too_far", 1.35, 103.805, 500, "", 0, 0},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			j := NewWeatherJoin(&WeatherObservations{AirTemperature: newTestAirTemperature(30, 32)}, nil)
+			j.MaxDistance = tc.maxDistance
+			w := j.At(tc.latitude, tc.longitude)
+			if w.Rainfall != nil || w.Forecast != nil {
+				t.Errorf("got %+v %+v for missing resources", w.Rainfall, w.Forecast)
+			}
+			got := w.AirTemperature
+			if tc.station == "" {
+				if got != nil {
+					t.Errorf("got %+v want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("got nil want station %v", tc.station)
+			}
+			if got.StationID != tc.station || got.Value != tc.value || math.Abs(got.Distance-tc.distance) > 1 {
+				t.Errorf("got %+v want %v %v %v", got, tc.station, tc.value, tc.distance)
+			}
+		})
+	}
+}
+
+func TestWeatherJoin_Forecast(t *testing.T) {
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	j := NewWeatherJoin(nil, f)
+
+	// Create test cases
+	cases := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      string
+	}{
+		{"raffles_place", 1.2840, 103.8514, "City"},
+		{"sentosa", 1.2494, 103.8303, "Sentosa"},
+		{"pulau_ubin", 1.4100, 103.9600, "Pulau Ubin"},
+		{"changi_village", 1.3890, 103.9880, "Changi"},
+		{"punggol_point", 1.4180, 103.9100, "Punggol"},
+		{"lim_chu_kang", 1.4380, 103.7050, "Lim Chu Kang"},
+		{"johor_bahru", 1.4927, 103.7414, ""},
+	}
+
+	// Run test cases
+	for _, tc := range cases {
+		tc := tc // capture range variable
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := j.At(tc.latitude, tc.longitude).Forecast
+			if tc.want == "" {
+				if got != nil {
+					t.Errorf("got %+v want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Area != tc.want || got.Forecast != "Windy" {
+				t.Errorf("got %+v want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWeatherJoin_Cameras(t *testing.T) {
+	// Load fixtures
+	ti := &TrafficImages{}
+	loadFixture(t, "testdata/fixtures/transport_trafficimages_default.json", ti)
+	rf := &Rainfall{}
+	loadFixture(t, "testdata/fixtures/environment_rainfall_default.json", rf)
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+
+	j := NewWeatherJoin(&WeatherObservations{Rainfall: rf}, f)
+	resolver := NewForecastAreaResolver(f)
+	samples := latestSamples(rf)
+	cameras := j.Cameras(ti)
+	if len(cameras) != len(ti.Items[0].Cameras) {
+		t.Fatalf("got %d cameras want %d", len(cameras), len(ti.Items[0].Cameras))
+	}
+	for _, c := range cameras {
+		p := geo.Point{Latitude: c.Location.Latitude, Longitude: c.Location.Longitude}
+
+		// Compare with the nearest station by brute force
+		want := math.Inf(1)
+		for _, s := range samples {
+			want = math.Min(want, geo.Distance(p, s.point))
+		}
+		if got := c.Weather.Rainfall; got == nil || math.Abs(got.Distance-want) > 1e-6 {
+			t.Errorf("camera %v: got %+v want distance %v", c.CameraID, got, want)
+		}
+
+		// Compare with the forecast area resolver
+		forecast, ok := resolver.Resolve(p.Latitude, p.Longitude)
+		if got := c.Weather.Forecast; !ok || got == nil || got.Area != forecast.Area || got.Forecast != forecast.Forecast {
+			t.Errorf("camera %v: got %+v want %+v", c.CameraID, got, forecast)
+		}
+		if c.Weather.AirTemperature != nil {
+			t.Errorf("camera %v: got air temperature without observations", c.CameraID)
+		}
+	}
+}
+
+func TestWeatherJoin_CoastalCameras(t *testing.T) {
+	f := &TwoHourWeatherForecast{}
+	loadFixture(t, "testdata/fixtures/environment_2hourweatherforecast_default.json", f)
+	j := NewWeatherJoin(nil, f)
+
+	// Cameras on the northern and western shores of the main island
+	ti := &TrafficImages{Items: []TrafficImagesItem{{Cameras: []TrafficImagesCamera{
+		{CameraID: "2701", Location: TrafficImagesCameraLocation{Latitude: 1.447023728, Longitude: 103.7716543}},
+		{CameraID: "4713", Location: TrafficImagesCameraLocation{Latitude: 1.341244001, Longitude: 103.6439134}},
+	}}}}
+	want := map[string]string{
+		"2701": "Woodlands",
+		"4713": "Jalan Bahar",
+	}
+	cameras := j.Cameras(ti)
+	if len(cameras) != len(want) {
+		t.Fatalf("got %d cameras want %d", len(cameras), len(want))
+	}
+	for _, c := range cameras {
+		if got := c.Weather.Forecast; got == nil || got.Area != want[c.CameraID] || got.Forecast != "Windy" {
+			t.Errorf("camera %v: got %+v want %v", c.CameraID, got, want[c.CameraID])
+		}
+	}
+}
+
+func TestWeatherJoin_Join(t *testing.T) {
+	j := NewWeatherJoin(&WeatherObservations{AirTemperature: newTestAirTemperature(30, 32)}, nil)
+
+	// Items of any type can be joined
+	stops := []struct {
+		name     string
+		location *geo.Point
+	}{
+		{"west", &geo.Point{Latitude: 1.35, Longitude: 103.789}},
+		{"unknown", nil},
+		{"east", &geo.Point{Latitude: 1.35, Longitude: 103.811}},
+	}
+	weather := j.Join(len(stops), func(i int) (geo.Point, bool) {
+		if stops[i].location == nil {
+			return geo.Point{}, false
+		}
+		return *stops[i].location, true
+	})
+	if len(weather) != len(stops) || weather[1] != nil {
+		t.Fatalf("got %+v want weather for located stops only", weather)
+	}
+	if weather[0].AirTemperature.StationID != "W" || weather[2].AirTemperature.StationID != "E" {
+		t.Errorf("got %+v %+v want stations W and E", weather[0].AirTemperature, weather[2].AirTemperature)
+	}
+
+	// Carparks without information are omitted
+	carparks := j.Carparks([]EnrichedCarpark{
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "UNKNOWN"}},
+		{CarparkAvailabilityCarpark: CarparkAvailabilityCarpark{CarparkNumber: "W1"}, Information: &CarparkInformation{Latitude: 1.35, Longitude: 103.79}},
+	})
+	if len(carparks) != 1 || carparks[0].CarparkNumber != "W1" || carparks[0].Weather.AirTemperature.Value != 30 {
+		t.Errorf("got %+v want carpark W1 at 30 degrees", carparks)
+	}
+}